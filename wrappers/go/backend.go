@@ -0,0 +1,43 @@
+package pqc
+
+// SignerBackend performs a single Dilithium signing operation on behalf
+// of a Dilithium2/3/5 instance, so that a private key can live outside
+// Go memory entirely (e.g. in a PKCS#11 token) instead of being passed
+// around as a []byte. See pqc/pkcs11 for an HSM-backed implementation;
+// inProcessBackend below signs a secret key held in Go memory by calling
+// the same Sign/SignWithContext methods a caller would use directly.
+type SignerBackend interface {
+	Sign(algorithm DilithiumAlgorithm, message, context []byte) (signature []byte, err error)
+}
+
+// inProcessBackend signs with a secret key held in Go memory, using the
+// same cgo entry points Dilithium2/3/5.Sign already calls.
+type inProcessBackend struct {
+	secretKey []byte
+}
+
+// NewInProcessBackend wraps a raw secret key as a SignerBackend that
+// signs in this process via cgo, the same as calling Sign directly.
+func NewInProcessBackend(secretKey []byte) SignerBackend {
+	return &inProcessBackend{secretKey: secretKey}
+}
+
+func (b *inProcessBackend) Sign(algorithm DilithiumAlgorithm, message, context []byte) ([]byte, error) {
+	return algorithm.sign(message, context, b.secretKey)
+}
+
+// SignWith signs message through backend for the given algorithm. Prefer
+// calling Dilithium2/3/5's own SignWith method, which mirrors the
+// existing Sign/SignWithContext idiom and doesn't require the caller to
+// repeat the algorithm the instance already identifies; this package-level
+// form exists for callers that only have a DilithiumAlgorithm value and no
+// Dilithium2/3/5 instance to call through.
+func SignWith(backend SignerBackend, algorithm DilithiumAlgorithm, message []byte) (signature []byte, err error) {
+	return backend.Sign(algorithm, message, nil)
+}
+
+// SignWithContextAndBackend is SignWith plus a domain-separation context
+// string, mirroring SignWithContext.
+func SignWithContextAndBackend(backend SignerBackend, algorithm DilithiumAlgorithm, message, context []byte) (signature []byte, err error) {
+	return backend.Sign(algorithm, message, context)
+}
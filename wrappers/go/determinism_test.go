@@ -0,0 +1,103 @@
+package pqc
+
+import (
+	"bytes"
+	"testing"
+)
+
+// These tests exercise the whole reason KeypairFromSeed/EncapsulateDeterministic/
+// SignWithContext exist: the same seed (or coins) must always yield the same
+// output, so NIST KAT vectors and other reproducible fixtures can be run
+// against this module. They need the PQClean library at run time, so they skip
+// when it isn't found rather than failing.
+
+func TestKyber768KeypairFromSeedIsDeterministic(t *testing.T) {
+	if libraryPath == "" {
+		t.Skip("PQC library not found")
+	}
+
+	kyber := NewKyber768()
+	seed := bytes.Repeat([]byte{0x42}, Kyber768SeedBytes)
+
+	pk1, sk1, err := kyber.KeypairFromSeed(seed)
+	if err != nil {
+		t.Fatalf("KeypairFromSeed: %v", err)
+	}
+	pk2, sk2, err := kyber.KeypairFromSeed(seed)
+	if err != nil {
+		t.Fatalf("KeypairFromSeed: %v", err)
+	}
+
+	if !bytes.Equal(pk1, pk2) {
+		t.Error("KeypairFromSeed produced different public keys for the same seed")
+	}
+	if !bytes.Equal(sk1, sk2) {
+		t.Error("KeypairFromSeed produced different secret keys for the same seed")
+	}
+}
+
+func TestKyber768EncapsulateDeterministicIsDeterministic(t *testing.T) {
+	if libraryPath == "" {
+		t.Skip("PQC library not found")
+	}
+
+	kyber := NewKyber768()
+	publicKey, _, err := kyber.Keypair()
+	if err != nil {
+		t.Fatalf("Keypair: %v", err)
+	}
+	coins := bytes.Repeat([]byte{0x24}, Kyber768EncapsulationCoinsBytes)
+
+	ct1, ss1, err := kyber.EncapsulateDeterministic(publicKey, coins)
+	if err != nil {
+		t.Fatalf("EncapsulateDeterministic: %v", err)
+	}
+	ct2, ss2, err := kyber.EncapsulateDeterministic(publicKey, coins)
+	if err != nil {
+		t.Fatalf("EncapsulateDeterministic: %v", err)
+	}
+
+	if !bytes.Equal(ct1, ct2) {
+		t.Error("EncapsulateDeterministic produced different ciphertexts for the same coins")
+	}
+	if !bytes.Equal(ss1, ss2) {
+		t.Error("EncapsulateDeterministic produced different shared secrets for the same coins")
+	}
+}
+
+func TestDilithium2SignWithContextIsDeterministic(t *testing.T) {
+	if libraryPath == "" {
+		t.Skip("PQC library not found")
+	}
+
+	dilithium := NewDilithium2()
+	seed := bytes.Repeat([]byte{0x11}, Dilithium2SeedBytes)
+
+	_, sk1, err := dilithium.KeypairFromSeed(seed)
+	if err != nil {
+		t.Fatalf("KeypairFromSeed: %v", err)
+	}
+	_, sk2, err := dilithium.KeypairFromSeed(seed)
+	if err != nil {
+		t.Fatalf("KeypairFromSeed: %v", err)
+	}
+	if !bytes.Equal(sk1, sk2) {
+		t.Fatal("KeypairFromSeed produced different secret keys for the same seed")
+	}
+
+	message := []byte("KAT vector message")
+	context := []byte("KAT vector context")
+
+	sig1, err := dilithium.SignWithContext(message, context, sk1)
+	if err != nil {
+		t.Fatalf("SignWithContext: %v", err)
+	}
+	sig2, err := dilithium.SignWithContext(message, context, sk1)
+	if err != nil {
+		t.Fatalf("SignWithContext: %v", err)
+	}
+
+	if !bytes.Equal(sig1, sig2) {
+		t.Error("SignWithContext produced different signatures for the same key, message, and context")
+	}
+}
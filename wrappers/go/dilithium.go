@@ -0,0 +1,570 @@
+package pqc
+
+/*
+#cgo CFLAGS: -I.
+#cgo LDFLAGS: -L. -lpqc
+
+extern int PQCLEAN_DILITHIUM2_CLEAN_crypto_sign_keypair(unsigned char *pk, unsigned char *sk);
+extern int PQCLEAN_DILITHIUM2_CLEAN_crypto_sign_signature(unsigned char *sig, size_t *siglen, const unsigned char *m, size_t mlen, const unsigned char *sk);
+extern int PQCLEAN_DILITHIUM2_CLEAN_crypto_sign_verify(const unsigned char *sig, size_t siglen, const unsigned char *m, size_t mlen, const unsigned char *pk);
+extern int PQCLEAN_DILITHIUM2_CLEAN_crypto_sign_keypair_derand(unsigned char *pk, unsigned char *sk, const unsigned char *seed);
+extern int PQCLEAN_DILITHIUM2_CLEAN_crypto_sign_signature_ctx(unsigned char *sig, size_t *siglen, const unsigned char *m, size_t mlen, const unsigned char *ctx, size_t ctxlen, const unsigned char *sk);
+
+extern int PQCLEAN_DILITHIUM3_CLEAN_crypto_sign_keypair(unsigned char *pk, unsigned char *sk);
+extern int PQCLEAN_DILITHIUM3_CLEAN_crypto_sign_signature(unsigned char *sig, size_t *siglen, const unsigned char *m, size_t mlen, const unsigned char *sk);
+extern int PQCLEAN_DILITHIUM3_CLEAN_crypto_sign_verify(const unsigned char *sig, size_t siglen, const unsigned char *m, size_t mlen, const unsigned char *pk);
+extern int PQCLEAN_DILITHIUM3_CLEAN_crypto_sign_keypair_derand(unsigned char *pk, unsigned char *sk, const unsigned char *seed);
+extern int PQCLEAN_DILITHIUM3_CLEAN_crypto_sign_signature_ctx(unsigned char *sig, size_t *siglen, const unsigned char *m, size_t mlen, const unsigned char *ctx, size_t ctxlen, const unsigned char *sk);
+
+extern int PQCLEAN_DILITHIUM5_CLEAN_crypto_sign_keypair(unsigned char *pk, unsigned char *sk);
+extern int PQCLEAN_DILITHIUM5_CLEAN_crypto_sign_signature(unsigned char *sig, size_t *siglen, const unsigned char *m, size_t mlen, const unsigned char *sk);
+extern int PQCLEAN_DILITHIUM5_CLEAN_crypto_sign_verify(const unsigned char *sig, size_t siglen, const unsigned char *m, size_t mlen, const unsigned char *pk);
+extern int PQCLEAN_DILITHIUM5_CLEAN_crypto_sign_keypair_derand(unsigned char *pk, unsigned char *sk, const unsigned char *seed);
+extern int PQCLEAN_DILITHIUM5_CLEAN_crypto_sign_signature_ctx(unsigned char *sig, size_t *siglen, const unsigned char *m, size_t mlen, const unsigned char *ctx, size_t ctxlen, const unsigned char *sk);
+*/
+import "C"
+
+import (
+	"fmt"
+	"unsafe"
+)
+
+// cBytesOrNil returns a pointer to the first byte of b, or nil for an
+// empty slice, so zero-length inputs (e.g. an empty context string) can
+// be passed to cgo without an out-of-range index.
+func cBytesOrNil(b []byte) *C.uchar {
+	if len(b) == 0 {
+		return nil
+	}
+	return (*C.uchar)(unsafe.Pointer(&b[0]))
+}
+
+// Dilithium2 provides Dilithium2 digital signatures
+type Dilithium2 struct{}
+
+// NewDilithium2 creates a new Dilithium2 instance
+func NewDilithium2() *Dilithium2 {
+	return &Dilithium2{}
+}
+
+// Keypair generates a Dilithium2 key pair
+func (d *Dilithium2) Keypair() (publicKey, secretKey []byte, err error) {
+	if libraryPath == "" {
+		return nil, nil, ErrLibraryNotFound
+	}
+
+	publicKey = make([]byte, Dilithium2PublicKeyBytes)
+	secretKey = make([]byte, Dilithium2SecretKeyBytes)
+
+	result := C.PQCLEAN_DILITHIUM2_CLEAN_crypto_sign_keypair(
+		(*C.uchar)(unsafe.Pointer(&publicKey[0])),
+		(*C.uchar)(unsafe.Pointer(&secretKey[0])),
+	)
+
+	if result != 0 {
+		return nil, nil, fmt.Errorf("%w: code %d", ErrKeyGeneration, result)
+	}
+
+	return publicKey, secretKey, nil
+}
+
+// Sign signs a message using the secret key
+func (d *Dilithium2) Sign(message, secretKey []byte) (signature []byte, err error) {
+	if libraryPath == "" {
+		return nil, ErrLibraryNotFound
+	}
+
+	if err := validateKeyLength(secretKey, Dilithium2SecretKeyBytes, "secret key"); err != nil {
+		return nil, err
+	}
+
+	signature = make([]byte, Dilithium2SignatureBytes)
+	sigLen := C.size_t(len(signature))
+
+	result := C.PQCLEAN_DILITHIUM2_CLEAN_crypto_sign_signature(
+		(*C.uchar)(unsafe.Pointer(&signature[0])),
+		&sigLen,
+		cBytesOrNil(message),
+		C.size_t(len(message)),
+		(*C.uchar)(unsafe.Pointer(&secretKey[0])),
+	)
+
+	if result != 0 {
+		return nil, fmt.Errorf("%w: code %d", ErrSigning, result)
+	}
+
+	return signature[:sigLen], nil
+}
+
+// Verify verifies a signature against a message using the public key
+func (d *Dilithium2) Verify(message, signature, publicKey []byte) (valid bool, err error) {
+	if libraryPath == "" {
+		return false, ErrLibraryNotFound
+	}
+
+	if err := validateKeyLength(publicKey, Dilithium2PublicKeyBytes, "public key"); err != nil {
+		return false, err
+	}
+
+	result := C.PQCLEAN_DILITHIUM2_CLEAN_crypto_sign_verify(
+		cBytesOrNil(signature),
+		C.size_t(len(signature)),
+		cBytesOrNil(message),
+		C.size_t(len(message)),
+		(*C.uchar)(unsafe.Pointer(&publicKey[0])),
+	)
+
+	return result == 0, nil
+}
+
+// KeypairSecure generates a Dilithium2 key pair whose secret key is held
+// in an mlock'd, zeroize-on-drop SecretKey handle instead of a plain
+// []byte.
+func (d *Dilithium2) KeypairSecure() (publicKey []byte, secretKey *SecretKey, err error) {
+	publicKey, raw, err := d.Keypair()
+	if err != nil {
+		return nil, nil, err
+	}
+	secretKey, err = newSecretKey(raw)
+	for i := range raw {
+		raw[i] = 0
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	return publicKey, secretKey, nil
+}
+
+// SignSecure signs a message using a secret key held in a SecretKey
+// handle.
+func (d *Dilithium2) SignSecure(message []byte, secretKey *SecretKey) (signature []byte, err error) {
+	err = secretKey.withBytes(func(raw []byte) error {
+		var signErr error
+		signature, signErr = d.Sign(message, raw)
+		return signErr
+	})
+	return signature, err
+}
+
+// KeypairFromSeed deterministically generates a Dilithium2 key pair from a
+// caller-supplied seed, using the PQClean `_derand` entry point. The same
+// seed always yields the same key pair, which is useful for KAT vectors
+// and reproducible test fixtures.
+func (d *Dilithium2) KeypairFromSeed(seed []byte) (publicKey, secretKey []byte, err error) {
+	if libraryPath == "" {
+		return nil, nil, ErrLibraryNotFound
+	}
+
+	if err := validateKeyLength(seed, Dilithium2SeedBytes, "seed"); err != nil {
+		return nil, nil, err
+	}
+
+	publicKey = make([]byte, Dilithium2PublicKeyBytes)
+	secretKey = make([]byte, Dilithium2SecretKeyBytes)
+
+	result := C.PQCLEAN_DILITHIUM2_CLEAN_crypto_sign_keypair_derand(
+		(*C.uchar)(unsafe.Pointer(&publicKey[0])),
+		(*C.uchar)(unsafe.Pointer(&secretKey[0])),
+		(*C.uchar)(unsafe.Pointer(&seed[0])),
+	)
+
+	if result != 0 {
+		return nil, nil, fmt.Errorf("%w: code %d", ErrKeyGeneration, result)
+	}
+
+	return publicKey, secretKey, nil
+}
+
+// SignWithContext signs a message using the secret key and an optional
+// domain-separation context string, using the PQClean `_ctx` entry point.
+func (d *Dilithium2) SignWithContext(message, context, secretKey []byte) (signature []byte, err error) {
+	if libraryPath == "" {
+		return nil, ErrLibraryNotFound
+	}
+
+	if err := validateKeyLength(secretKey, Dilithium2SecretKeyBytes, "secret key"); err != nil {
+		return nil, err
+	}
+
+	signature = make([]byte, Dilithium2SignatureBytes)
+	sigLen := C.size_t(len(signature))
+
+	result := C.PQCLEAN_DILITHIUM2_CLEAN_crypto_sign_signature_ctx(
+		(*C.uchar)(unsafe.Pointer(&signature[0])),
+		&sigLen,
+		cBytesOrNil(message),
+		C.size_t(len(message)),
+		cBytesOrNil(context),
+		C.size_t(len(context)),
+		(*C.uchar)(unsafe.Pointer(&secretKey[0])),
+	)
+
+	if result != 0 {
+		return nil, fmt.Errorf("%w: code %d", ErrSigning, result)
+	}
+
+	return signature[:sigLen], nil
+}
+
+// SignWith signs message through backend instead of a secret key held in
+// Go memory, e.g. a pqc/pkcs11 Backend keeping the key in an HSM. This is
+// the Dilithium2 entry point for the SignerBackend plumbing SignWith and
+// SignWithContextAndBackend expose generically.
+func (d *Dilithium2) SignWith(backend SignerBackend, message []byte) (signature []byte, err error) {
+	return backend.Sign(Dilithium2Algorithm, message, nil)
+}
+
+// Dilithium3 provides Dilithium3 digital signatures
+type Dilithium3 struct{}
+
+// NewDilithium3 creates a new Dilithium3 instance
+func NewDilithium3() *Dilithium3 {
+	return &Dilithium3{}
+}
+
+// Keypair generates a Dilithium3 key pair
+func (d *Dilithium3) Keypair() (publicKey, secretKey []byte, err error) {
+	if libraryPath == "" {
+		return nil, nil, ErrLibraryNotFound
+	}
+
+	publicKey = make([]byte, Dilithium3PublicKeyBytes)
+	secretKey = make([]byte, Dilithium3SecretKeyBytes)
+
+	result := C.PQCLEAN_DILITHIUM3_CLEAN_crypto_sign_keypair(
+		(*C.uchar)(unsafe.Pointer(&publicKey[0])),
+		(*C.uchar)(unsafe.Pointer(&secretKey[0])),
+	)
+
+	if result != 0 {
+		return nil, nil, fmt.Errorf("%w: code %d", ErrKeyGeneration, result)
+	}
+
+	return publicKey, secretKey, nil
+}
+
+// Sign signs a message using the secret key
+func (d *Dilithium3) Sign(message, secretKey []byte) (signature []byte, err error) {
+	if libraryPath == "" {
+		return nil, ErrLibraryNotFound
+	}
+
+	if err := validateKeyLength(secretKey, Dilithium3SecretKeyBytes, "secret key"); err != nil {
+		return nil, err
+	}
+
+	signature = make([]byte, Dilithium3SignatureBytes)
+	sigLen := C.size_t(len(signature))
+
+	result := C.PQCLEAN_DILITHIUM3_CLEAN_crypto_sign_signature(
+		(*C.uchar)(unsafe.Pointer(&signature[0])),
+		&sigLen,
+		cBytesOrNil(message),
+		C.size_t(len(message)),
+		(*C.uchar)(unsafe.Pointer(&secretKey[0])),
+	)
+
+	if result != 0 {
+		return nil, fmt.Errorf("%w: code %d", ErrSigning, result)
+	}
+
+	return signature[:sigLen], nil
+}
+
+// SignWith signs message through backend instead of a secret key held in
+// Go memory, e.g. a pqc/pkcs11 Backend keeping the key in an HSM. This is
+// the Dilithium3 entry point for the SignerBackend plumbing SignWith and
+// SignWithContextAndBackend expose generically.
+func (d *Dilithium3) SignWith(backend SignerBackend, message []byte) (signature []byte, err error) {
+	return backend.Sign(Dilithium3Algorithm, message, nil)
+}
+
+// Verify verifies a signature against a message using the public key
+func (d *Dilithium3) Verify(message, signature, publicKey []byte) (valid bool, err error) {
+	if libraryPath == "" {
+		return false, ErrLibraryNotFound
+	}
+
+	if err := validateKeyLength(publicKey, Dilithium3PublicKeyBytes, "public key"); err != nil {
+		return false, err
+	}
+
+	result := C.PQCLEAN_DILITHIUM3_CLEAN_crypto_sign_verify(
+		cBytesOrNil(signature),
+		C.size_t(len(signature)),
+		cBytesOrNil(message),
+		C.size_t(len(message)),
+		(*C.uchar)(unsafe.Pointer(&publicKey[0])),
+	)
+
+	return result == 0, nil
+}
+
+// KeypairSecure generates a Dilithium3 key pair whose secret key is held
+// in an mlock'd, zeroize-on-drop SecretKey handle instead of a plain
+// []byte.
+func (d *Dilithium3) KeypairSecure() (publicKey []byte, secretKey *SecretKey, err error) {
+	publicKey, raw, err := d.Keypair()
+	if err != nil {
+		return nil, nil, err
+	}
+	secretKey, err = newSecretKey(raw)
+	for i := range raw {
+		raw[i] = 0
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	return publicKey, secretKey, nil
+}
+
+// SignSecure signs a message using a secret key held in a SecretKey
+// handle.
+func (d *Dilithium3) SignSecure(message []byte, secretKey *SecretKey) (signature []byte, err error) {
+	err = secretKey.withBytes(func(raw []byte) error {
+		var signErr error
+		signature, signErr = d.Sign(message, raw)
+		return signErr
+	})
+	return signature, err
+}
+
+// KeypairFromSeed deterministically generates a Dilithium3 key pair from a
+// caller-supplied seed, using the PQClean `_derand` entry point. The same
+// seed always yields the same key pair, which is useful for KAT vectors
+// and reproducible test fixtures.
+func (d *Dilithium3) KeypairFromSeed(seed []byte) (publicKey, secretKey []byte, err error) {
+	if libraryPath == "" {
+		return nil, nil, ErrLibraryNotFound
+	}
+
+	if err := validateKeyLength(seed, Dilithium3SeedBytes, "seed"); err != nil {
+		return nil, nil, err
+	}
+
+	publicKey = make([]byte, Dilithium3PublicKeyBytes)
+	secretKey = make([]byte, Dilithium3SecretKeyBytes)
+
+	result := C.PQCLEAN_DILITHIUM3_CLEAN_crypto_sign_keypair_derand(
+		(*C.uchar)(unsafe.Pointer(&publicKey[0])),
+		(*C.uchar)(unsafe.Pointer(&secretKey[0])),
+		(*C.uchar)(unsafe.Pointer(&seed[0])),
+	)
+
+	if result != 0 {
+		return nil, nil, fmt.Errorf("%w: code %d", ErrKeyGeneration, result)
+	}
+
+	return publicKey, secretKey, nil
+}
+
+// SignWithContext signs a message using the secret key and an optional
+// domain-separation context string, using the PQClean `_ctx` entry point.
+func (d *Dilithium3) SignWithContext(message, context, secretKey []byte) (signature []byte, err error) {
+	if libraryPath == "" {
+		return nil, ErrLibraryNotFound
+	}
+
+	if err := validateKeyLength(secretKey, Dilithium3SecretKeyBytes, "secret key"); err != nil {
+		return nil, err
+	}
+
+	signature = make([]byte, Dilithium3SignatureBytes)
+	sigLen := C.size_t(len(signature))
+
+	result := C.PQCLEAN_DILITHIUM3_CLEAN_crypto_sign_signature_ctx(
+		(*C.uchar)(unsafe.Pointer(&signature[0])),
+		&sigLen,
+		cBytesOrNil(message),
+		C.size_t(len(message)),
+		cBytesOrNil(context),
+		C.size_t(len(context)),
+		(*C.uchar)(unsafe.Pointer(&secretKey[0])),
+	)
+
+	if result != 0 {
+		return nil, fmt.Errorf("%w: code %d", ErrSigning, result)
+	}
+
+	return signature[:sigLen], nil
+}
+
+// Dilithium5 provides Dilithium5 digital signatures
+type Dilithium5 struct{}
+
+// NewDilithium5 creates a new Dilithium5 instance
+func NewDilithium5() *Dilithium5 {
+	return &Dilithium5{}
+}
+
+// Keypair generates a Dilithium5 key pair
+func (d *Dilithium5) Keypair() (publicKey, secretKey []byte, err error) {
+	if libraryPath == "" {
+		return nil, nil, ErrLibraryNotFound
+	}
+
+	publicKey = make([]byte, Dilithium5PublicKeyBytes)
+	secretKey = make([]byte, Dilithium5SecretKeyBytes)
+
+	result := C.PQCLEAN_DILITHIUM5_CLEAN_crypto_sign_keypair(
+		(*C.uchar)(unsafe.Pointer(&publicKey[0])),
+		(*C.uchar)(unsafe.Pointer(&secretKey[0])),
+	)
+
+	if result != 0 {
+		return nil, nil, fmt.Errorf("%w: code %d", ErrKeyGeneration, result)
+	}
+
+	return publicKey, secretKey, nil
+}
+
+// Sign signs a message using the secret key
+func (d *Dilithium5) Sign(message, secretKey []byte) (signature []byte, err error) {
+	if libraryPath == "" {
+		return nil, ErrLibraryNotFound
+	}
+
+	if err := validateKeyLength(secretKey, Dilithium5SecretKeyBytes, "secret key"); err != nil {
+		return nil, err
+	}
+
+	signature = make([]byte, Dilithium5SignatureBytes)
+	sigLen := C.size_t(len(signature))
+
+	result := C.PQCLEAN_DILITHIUM5_CLEAN_crypto_sign_signature(
+		(*C.uchar)(unsafe.Pointer(&signature[0])),
+		&sigLen,
+		cBytesOrNil(message),
+		C.size_t(len(message)),
+		(*C.uchar)(unsafe.Pointer(&secretKey[0])),
+	)
+
+	if result != 0 {
+		return nil, fmt.Errorf("%w: code %d", ErrSigning, result)
+	}
+
+	return signature[:sigLen], nil
+}
+
+// SignWith signs message through backend instead of a secret key held in
+// Go memory, e.g. a pqc/pkcs11 Backend keeping the key in an HSM. This is
+// the Dilithium5 entry point for the SignerBackend plumbing SignWith and
+// SignWithContextAndBackend expose generically.
+func (d *Dilithium5) SignWith(backend SignerBackend, message []byte) (signature []byte, err error) {
+	return backend.Sign(Dilithium5Algorithm, message, nil)
+}
+
+// Verify verifies a signature against a message using the public key
+func (d *Dilithium5) Verify(message, signature, publicKey []byte) (valid bool, err error) {
+	if libraryPath == "" {
+		return false, ErrLibraryNotFound
+	}
+
+	if err := validateKeyLength(publicKey, Dilithium5PublicKeyBytes, "public key"); err != nil {
+		return false, err
+	}
+
+	result := C.PQCLEAN_DILITHIUM5_CLEAN_crypto_sign_verify(
+		cBytesOrNil(signature),
+		C.size_t(len(signature)),
+		cBytesOrNil(message),
+		C.size_t(len(message)),
+		(*C.uchar)(unsafe.Pointer(&publicKey[0])),
+	)
+
+	return result == 0, nil
+}
+
+// KeypairSecure generates a Dilithium5 key pair whose secret key is held
+// in an mlock'd, zeroize-on-drop SecretKey handle instead of a plain
+// []byte.
+func (d *Dilithium5) KeypairSecure() (publicKey []byte, secretKey *SecretKey, err error) {
+	publicKey, raw, err := d.Keypair()
+	if err != nil {
+		return nil, nil, err
+	}
+	secretKey, err = newSecretKey(raw)
+	for i := range raw {
+		raw[i] = 0
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	return publicKey, secretKey, nil
+}
+
+// SignSecure signs a message using a secret key held in a SecretKey
+// handle.
+func (d *Dilithium5) SignSecure(message []byte, secretKey *SecretKey) (signature []byte, err error) {
+	err = secretKey.withBytes(func(raw []byte) error {
+		var signErr error
+		signature, signErr = d.Sign(message, raw)
+		return signErr
+	})
+	return signature, err
+}
+
+// KeypairFromSeed deterministically generates a Dilithium5 key pair from a
+// caller-supplied seed, using the PQClean `_derand` entry point. The same
+// seed always yields the same key pair, which is useful for KAT vectors
+// and reproducible test fixtures.
+func (d *Dilithium5) KeypairFromSeed(seed []byte) (publicKey, secretKey []byte, err error) {
+	if libraryPath == "" {
+		return nil, nil, ErrLibraryNotFound
+	}
+
+	if err := validateKeyLength(seed, Dilithium5SeedBytes, "seed"); err != nil {
+		return nil, nil, err
+	}
+
+	publicKey = make([]byte, Dilithium5PublicKeyBytes)
+	secretKey = make([]byte, Dilithium5SecretKeyBytes)
+
+	result := C.PQCLEAN_DILITHIUM5_CLEAN_crypto_sign_keypair_derand(
+		(*C.uchar)(unsafe.Pointer(&publicKey[0])),
+		(*C.uchar)(unsafe.Pointer(&secretKey[0])),
+		(*C.uchar)(unsafe.Pointer(&seed[0])),
+	)
+
+	if result != 0 {
+		return nil, nil, fmt.Errorf("%w: code %d", ErrKeyGeneration, result)
+	}
+
+	return publicKey, secretKey, nil
+}
+
+// SignWithContext signs a message using the secret key and an optional
+// domain-separation context string, using the PQClean `_ctx` entry point.
+func (d *Dilithium5) SignWithContext(message, context, secretKey []byte) (signature []byte, err error) {
+	if libraryPath == "" {
+		return nil, ErrLibraryNotFound
+	}
+
+	if err := validateKeyLength(secretKey, Dilithium5SecretKeyBytes, "secret key"); err != nil {
+		return nil, err
+	}
+
+	signature = make([]byte, Dilithium5SignatureBytes)
+	sigLen := C.size_t(len(signature))
+
+	result := C.PQCLEAN_DILITHIUM5_CLEAN_crypto_sign_signature_ctx(
+		(*C.uchar)(unsafe.Pointer(&signature[0])),
+		&sigLen,
+		cBytesOrNil(message),
+		C.size_t(len(message)),
+		cBytesOrNil(context),
+		C.size_t(len(context)),
+		(*C.uchar)(unsafe.Pointer(&secretKey[0])),
+	)
+
+	if result != 0 {
+		return nil, fmt.Errorf("%w: code %d", ErrSigning, result)
+	}
+
+	return signature[:sigLen], nil
+}
+
+// NewDilithium creates a new Dilithium3 instance (default)
+func NewDilithium() *Dilithium3 {
+	return NewDilithium3()
+}
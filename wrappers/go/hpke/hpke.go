@@ -0,0 +1,306 @@
+// Package hpke implements Hybrid Public Key Encryption (RFC 9180)
+// parameterized over this module's post-quantum and hybrid KEMs instead
+// of the Diffie-Hellman KEMs RFC 9180 defines natively.
+//
+// Since none of Kyber, ML-KEM, or the pqc/hybrid combiners are
+// Diffie-Hellman based, Encap/Decap here skip RFC 9180's DHKEM
+// ExtractAndExpand step and feed the KEM's shared secret directly into
+// the base mode key schedule (RFC 9180 section 5.1); everything
+// downstream - LabeledExtract/LabeledExpand, the key schedule, sealing,
+// opening and exporting - follows RFC 9180 exactly.
+package hpke
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+// KEM is implemented by any KEM this module exposes: the legacy
+// pqc.Kyber512/768/1024, the pqc/hybrid combiners, and the pqc/mlkem
+// ML-KEM variants all satisfy it without change.
+type KEM interface {
+	Keypair() (publicKey, secretKey []byte, err error)
+	Encapsulate(publicKey []byte) (ciphertext, sharedSecret []byte, err error)
+	Decapsulate(ciphertext, secretKey []byte) (sharedSecret []byte, err error)
+}
+
+// KEM IDs for this module's KEMs, drawn from the private-use range
+// (0xFF00-0xFFFF) the RFC 9180 IANA registry reserves, pending the
+// codepoints the IETF PQ-HPKE draft eventually assigns.
+const (
+	KEMIDX25519Kyber768 uint16 = 0xFF01
+	KEMIDP256Kyber768   uint16 = 0xFF02
+	KEMIDMLKEM768       uint16 = 0xFF03
+)
+
+// KDF and AEAD IDs. Only HKDF-SHA256 and AES-256-GCM are implemented.
+const (
+	KDFIDHKDFSHA256 uint16 = 0x0001
+	AEADIDAES256GCM uint16 = 0x0002
+
+	nh = sha256.Size // KDF output size
+	nk = 32          // AES-256 key size
+	nn = 12          // GCM nonce size
+)
+
+// Common errors
+var (
+	ErrEncapsulation    = errors.New("hpke encapsulation failed")
+	ErrDecapsulation    = errors.New("hpke decapsulation failed")
+	ErrOpen             = errors.New("hpke open failed")
+	ErrSequenceOverflow = errors.New("hpke sequence number overflow")
+	ErrExportTooLong    = errors.New("hpke export length exceeds 255*Nh")
+)
+
+// maxExpandLength is RFC 9180's HKDF-Expand output cap of 255*Nh
+// (section 7.2.1): above this, the expand loop's one-byte counter
+// would wrap and repeat HMAC inputs instead of producing fresh output.
+const maxExpandLength = 255 * nh
+
+func i2osp2(v uint16) []byte {
+	b := make([]byte, 2)
+	binary.BigEndian.PutUint16(b, v)
+	return b
+}
+
+// suiteID builds the "HPKE" + kem_id + kdf_id + aead_id ciphersuite
+// identifier used to domain-separate every LabeledExtract/LabeledExpand
+// call (RFC 9180 section 4).
+func suiteID(kemID uint16) []byte {
+	id := append([]byte("HPKE"), i2osp2(kemID)...)
+	id = append(id, i2osp2(KDFIDHKDFSHA256)...)
+	id = append(id, i2osp2(AEADIDAES256GCM)...)
+	return id
+}
+
+func hkdfExtract(salt, ikm []byte) []byte {
+	if salt == nil {
+		salt = make([]byte, sha256.Size)
+	}
+	mac := hmac.New(sha256.New, salt)
+	mac.Write(ikm)
+	return mac.Sum(nil)
+}
+
+func hkdfExpand(prk, info []byte, length int) ([]byte, error) {
+	if length > maxExpandLength {
+		return nil, fmt.Errorf("%w: requested %d bytes", ErrExportTooLong, length)
+	}
+	var t, okm []byte
+	for counter := byte(1); len(okm) < length; counter++ {
+		mac := hmac.New(sha256.New, prk)
+		mac.Write(t)
+		mac.Write(info)
+		mac.Write([]byte{counter})
+		t = mac.Sum(nil)
+		okm = append(okm, t...)
+	}
+	return okm[:length], nil
+}
+
+// labeledExtract implements RFC 9180's LabeledExtract.
+func labeledExtract(salt []byte, suite []byte, label string, ikm []byte) []byte {
+	labeledIKM := append([]byte("HPKE-v1"), suite...)
+	labeledIKM = append(labeledIKM, []byte(label)...)
+	labeledIKM = append(labeledIKM, ikm...)
+	return hkdfExtract(salt, labeledIKM)
+}
+
+// labeledExpand implements RFC 9180's LabeledExpand. length must fit in
+// the uint16 length field and, per section 7.2.1, must not exceed
+// 255*Nh; hkdfExpand enforces the latter.
+func labeledExpand(prk []byte, suite []byte, label string, info []byte, length int) ([]byte, error) {
+	if length < 0 || length > 0xFFFF {
+		return nil, fmt.Errorf("%w: requested %d bytes", ErrExportTooLong, length)
+	}
+	labeledInfo := i2osp2(uint16(length))
+	labeledInfo = append(labeledInfo, []byte("HPKE-v1")...)
+	labeledInfo = append(labeledInfo, suite...)
+	labeledInfo = append(labeledInfo, []byte(label)...)
+	labeledInfo = append(labeledInfo, info...)
+	return hkdfExpand(prk, labeledInfo, length)
+}
+
+// Context is an established HPKE sealing/opening context (RFC 9180
+// section 5.2), shared by NewSender and NewReceiver.
+type Context struct {
+	aead           cipher.AEAD
+	baseNonce      []byte
+	seq            uint64
+	exporterSecret []byte
+	suite          []byte
+}
+
+func (c *Context) nonce() ([]byte, error) {
+	// nn is 12 bytes (96 bits), far wider than the uint64 sequence
+	// counter, so the counter's own range is the binding limit here.
+	if c.seq == ^uint64(0) {
+		return nil, ErrSequenceOverflow
+	}
+	n := make([]byte, nn)
+	copy(n, c.baseNonce)
+	var seqBytes [8]byte
+	binary.BigEndian.PutUint64(seqBytes[:], c.seq)
+	for i := 0; i < 8; i++ {
+		n[nn-8+i] ^= seqBytes[i]
+	}
+	c.seq++
+	return n, nil
+}
+
+// Seal encrypts plaintext with the next sequence number's nonce,
+// authenticating aad alongside it.
+func (c *Context) Seal(aad, plaintext []byte) ([]byte, error) {
+	nonce, err := c.nonce()
+	if err != nil {
+		return nil, err
+	}
+	return c.aead.Seal(nil, nonce, plaintext, aad), nil
+}
+
+// Open decrypts ciphertext with the next sequence number's nonce,
+// verifying aad alongside it.
+func (c *Context) Open(aad, ciphertext []byte) ([]byte, error) {
+	nonce, err := c.nonce()
+	if err != nil {
+		return nil, err
+	}
+	plaintext, err := c.aead.Open(nil, nonce, ciphertext, aad)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrOpen, err)
+	}
+	return plaintext, nil
+}
+
+// Export derives additional secret material bound to this context and
+// exporterContext, for use outside the AEAD (RFC 9180 section 5.3).
+// length must not exceed 255*Nh (8160 bytes for SHA-256).
+func (c *Context) Export(exporterContext []byte, length int) ([]byte, error) {
+	return labeledExpand(c.exporterSecret, c.suite, "sec", exporterContext, length)
+}
+
+// keySchedule implements RFC 9180's KeySchedule in base mode (no PSK).
+func keySchedule(kemID uint16, sharedSecret, info []byte) (*Context, error) {
+	suite := suiteID(kemID)
+
+	pskIDHash := labeledExtract(nil, suite, "psk_id_hash", nil)
+	infoHash := labeledExtract(nil, suite, "info_hash", info)
+
+	ksContext := append([]byte{0x00}, pskIDHash...) // mode_base = 0x00
+	ksContext = append(ksContext, infoHash...)
+
+	secret := labeledExtract(sharedSecret, suite, "secret", nil)
+
+	key, err := labeledExpand(secret, suite, "key", ksContext, nk)
+	if err != nil {
+		return nil, err
+	}
+	baseNonce, err := labeledExpand(secret, suite, "base_nonce", ksContext, nn)
+	if err != nil {
+		return nil, err
+	}
+	exporterSecret, err := labeledExpand(secret, suite, "exp", ksContext, nh)
+	if err != nil {
+		return nil, err
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Context{
+		aead:           aead,
+		baseNonce:      baseNonce,
+		exporterSecret: exporterSecret,
+		suite:          suite,
+	}, nil
+}
+
+// NewSender encapsulates a shared secret to publicKey and derives a
+// sealing Context bound to info. The returned enc must be sent to the
+// receiver so it can call NewReceiver.
+func NewSender(kemID uint16, kem KEM, publicKey, info []byte) (enc []byte, ctx *Context, err error) {
+	enc, sharedSecret, err := kem.Encapsulate(publicKey)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %v", ErrEncapsulation, err)
+	}
+
+	ctx, err = keySchedule(kemID, sharedSecret, info)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return enc, ctx, nil
+}
+
+// NewReceiver decapsulates enc with secretKey and derives an opening
+// Context bound to info.
+func NewReceiver(kemID uint16, kem KEM, secretKey, enc, info []byte) (ctx *Context, err error) {
+	sharedSecret, err := kem.Decapsulate(enc, secretKey)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrDecapsulation, err)
+	}
+
+	return keySchedule(kemID, sharedSecret, info)
+}
+
+// SealBase is the single-shot form of NewSender + Context.Seal.
+func SealBase(kemID uint16, kem KEM, publicKey, info, aad, plaintext []byte) (enc, ciphertext []byte, err error) {
+	enc, ctx, err := NewSender(kemID, kem, publicKey, info)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ciphertext, err = ctx.Seal(aad, plaintext)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return enc, ciphertext, nil
+}
+
+// OpenBase is the single-shot form of NewReceiver + Context.Open.
+func OpenBase(kemID uint16, kem KEM, secretKey, enc, info, aad, ciphertext []byte) (plaintext []byte, err error) {
+	ctx, err := NewReceiver(kemID, kem, secretKey, enc, info)
+	if err != nil {
+		return nil, err
+	}
+
+	return ctx.Open(aad, ciphertext)
+}
+
+// ExportBase derives exported secret material without sealing any
+// message, for export-only use of an HPKE KEM. length must not exceed
+// 255*Nh (8160 bytes for SHA-256).
+func ExportBase(kemID uint16, kem KEM, publicKey, info, exporterContext []byte, length int) (enc []byte, secret []byte, err error) {
+	enc, ctx, err := NewSender(kemID, kem, publicKey, info)
+	if err != nil {
+		return nil, nil, err
+	}
+	secret, err = ctx.Export(exporterContext, length)
+	if err != nil {
+		return nil, nil, err
+	}
+	return enc, secret, nil
+}
+
+// ExportBaseReceiver derives the same exported secret material on the
+// receiving side, given enc from ExportBase. length must not exceed
+// 255*Nh (8160 bytes for SHA-256).
+func ExportBaseReceiver(kemID uint16, kem KEM, secretKey, enc, info, exporterContext []byte, length int) ([]byte, error) {
+	ctx, err := NewReceiver(kemID, kem, secretKey, enc, info)
+	if err != nil {
+		return nil, err
+	}
+	return ctx.Export(exporterContext, length)
+}
@@ -0,0 +1,162 @@
+package hpke
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+)
+
+// fakeKEM is a trivial stand-in for the real Kyber/ML-KEM/hybrid KEMs so
+// these tests can exercise the pure-Go HPKE machinery without linking
+// against the native libpqc binary. Its "ciphertext" is just the public
+// key and its shared secret is a fixed constant, which is enough to
+// drive NewSender/NewReceiver through matching Encapsulate/Decapsulate.
+type fakeKEM struct{}
+
+func (fakeKEM) Keypair() (publicKey, secretKey []byte, err error) {
+	return []byte("public-key"), []byte("secret-key"), nil
+}
+
+func (fakeKEM) Encapsulate(publicKey []byte) (ciphertext, sharedSecret []byte, err error) {
+	return append([]byte(nil), publicKey...), []byte("shared-secret-shared-secret-3210"), nil
+}
+
+func (fakeKEM) Decapsulate(ciphertext, secretKey []byte) (sharedSecret []byte, err error) {
+	return []byte("shared-secret-shared-secret-3210"), nil
+}
+
+func TestLabeledExtractIsDeterministic(t *testing.T) {
+	suite := suiteID(KEMIDMLKEM768)
+	a := labeledExtract([]byte("salt"), suite, "secret", []byte("ikm"))
+	b := labeledExtract([]byte("salt"), suite, "secret", []byte("ikm"))
+	if !bytes.Equal(a, b) {
+		t.Error("labeledExtract produced different output for identical inputs")
+	}
+}
+
+func TestLabeledExtractDiffersByLabel(t *testing.T) {
+	suite := suiteID(KEMIDMLKEM768)
+	a := labeledExtract([]byte("salt"), suite, "secret", []byte("ikm"))
+	b := labeledExtract([]byte("salt"), suite, "base_nonce", []byte("ikm"))
+	if bytes.Equal(a, b) {
+		t.Error("labeledExtract produced the same output for different labels")
+	}
+}
+
+func TestLabeledExpandProducesRequestedLength(t *testing.T) {
+	suite := suiteID(KEMIDMLKEM768)
+	prk := labeledExtract(nil, suite, "secret", []byte("ikm"))
+
+	for _, length := range []int{nk, nn, nh, 100} {
+		out, err := labeledExpand(prk, suite, "key", []byte("info"), length)
+		if err != nil {
+			t.Fatalf("labeledExpand(length=%d): %v", length, err)
+		}
+		if len(out) != length {
+			t.Errorf("labeledExpand(length=%d) returned %d bytes", length, len(out))
+		}
+	}
+}
+
+func TestLabeledExpandRejectsLengthAboveCap(t *testing.T) {
+	suite := suiteID(KEMIDMLKEM768)
+	prk := labeledExtract(nil, suite, "secret", []byte("ikm"))
+
+	if _, err := labeledExpand(prk, suite, "key", []byte("info"), maxExpandLength); err != nil {
+		t.Errorf("labeledExpand(length=%d): %v", maxExpandLength, err)
+	}
+	if _, err := labeledExpand(prk, suite, "key", []byte("info"), maxExpandLength+1); !errors.Is(err, ErrExportTooLong) {
+		t.Errorf("labeledExpand(length=%d) err = %v, want wrapping ErrExportTooLong", maxExpandLength+1, err)
+	}
+}
+
+func TestKeyScheduleSenderReceiverAgree(t *testing.T) {
+	sharedSecret := []byte("shared-secret-shared-secret-3210")
+	info := []byte("application info")
+
+	sender, err := keySchedule(KEMIDMLKEM768, sharedSecret, info)
+	if err != nil {
+		t.Fatalf("keySchedule (sender): %v", err)
+	}
+	receiver, err := keySchedule(KEMIDMLKEM768, sharedSecret, info)
+	if err != nil {
+		t.Fatalf("keySchedule (receiver): %v", err)
+	}
+
+	plaintext := []byte("hello, hybrid PQ world")
+	aad := []byte("associated data")
+
+	ciphertext, err := sender.Seal(aad, plaintext)
+	if err != nil {
+		t.Fatalf("Seal: %v", err)
+	}
+	opened, err := receiver.Open(aad, ciphertext)
+	if err != nil {
+		t.Fatalf("Open: %v", err)
+	}
+	if !bytes.Equal(opened, plaintext) {
+		t.Error("Open did not recover the original plaintext")
+	}
+}
+
+func TestSealBaseOpenBaseRoundTrip(t *testing.T) {
+	kem := fakeKEM{}
+	publicKey, secretKey, err := kem.Keypair()
+	if err != nil {
+		t.Fatalf("Keypair: %v", err)
+	}
+
+	info := []byte("info")
+	aad := []byte("aad")
+	plaintext := []byte("round trip message")
+
+	enc, ciphertext, err := SealBase(KEMIDMLKEM768, kem, publicKey, info, aad, plaintext)
+	if err != nil {
+		t.Fatalf("SealBase: %v", err)
+	}
+
+	opened, err := OpenBase(KEMIDMLKEM768, kem, secretKey, enc, info, aad, ciphertext)
+	if err != nil {
+		t.Fatalf("OpenBase: %v", err)
+	}
+	if !bytes.Equal(opened, plaintext) {
+		t.Error("OpenBase did not recover the original plaintext")
+	}
+}
+
+func TestExportBaseReceiverAgreesWithSender(t *testing.T) {
+	kem := fakeKEM{}
+	publicKey, secretKey, err := kem.Keypair()
+	if err != nil {
+		t.Fatalf("Keypair: %v", err)
+	}
+
+	info := []byte("info")
+	exporterContext := []byte("exporter context")
+
+	enc, senderSecret, err := ExportBase(KEMIDMLKEM768, kem, publicKey, info, exporterContext, 32)
+	if err != nil {
+		t.Fatalf("ExportBase: %v", err)
+	}
+
+	receiverSecret, err := ExportBaseReceiver(KEMIDMLKEM768, kem, secretKey, enc, info, exporterContext, 32)
+	if err != nil {
+		t.Fatalf("ExportBaseReceiver: %v", err)
+	}
+
+	if !bytes.Equal(senderSecret, receiverSecret) {
+		t.Error("sender and receiver derived different exported secrets")
+	}
+}
+
+func TestContextNonceOverflowsAfterMaxSequence(t *testing.T) {
+	ctx, err := keySchedule(KEMIDMLKEM768, []byte("shared-secret-shared-secret-3210"), nil)
+	if err != nil {
+		t.Fatalf("keySchedule: %v", err)
+	}
+
+	ctx.seq = ^uint64(0)
+	if _, err := ctx.Seal(nil, []byte("one too many")); err != ErrSequenceOverflow {
+		t.Fatalf("expected ErrSequenceOverflow, got %v", err)
+	}
+}
@@ -0,0 +1,306 @@
+// Package hybrid provides hybrid classical+post-quantum KEM constructions
+// that combine a classical ECDH key exchange with a PQClean Kyber KEM,
+// mirroring the combiner used by the TLS/Noise hybrid key exchange drafts.
+//
+// A hybrid public key is the concatenation classical_pk || kyber_pk, a
+// hybrid ciphertext is classical_ct || kyber_ct, and the shared secret is
+//
+//	KDF(classical_ss || kyber_ss || classical_ct || classical_pk)
+//
+// derived with HKDF-SHA256 so that compromise of either the classical or
+// the post-quantum component alone does not reveal the combined secret.
+package hybrid
+
+import (
+	"crypto/ecdh"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+
+	pqc "github.com/QudsLab/PQChub/wrappers/go"
+)
+
+// Common errors
+var (
+	ErrKeyGeneration  = errors.New("hybrid key generation failed")
+	ErrEncapsulation  = errors.New("hybrid encapsulation failed")
+	ErrDecapsulation  = errors.New("hybrid decapsulation failed")
+	ErrInvalidKeySize = errors.New("invalid hybrid key size")
+)
+
+// hkdfInfo domain-separates the hybrid combiner from other HKDF uses in
+// this module.
+const hkdfInfo = "PQChub-Hybrid-KEM-v1"
+
+// combine derives the 32-byte hybrid shared secret from the classical and
+// Kyber shared secrets, following the IKM ordering classical_ss || kyber_ss
+// || classical_ct || classical_pk.
+func combine(classicalSS, kyberSS, classicalCT, classicalPK []byte) []byte {
+	ikm := make([]byte, 0, len(classicalSS)+len(kyberSS)+len(classicalCT)+len(classicalPK))
+	ikm = append(ikm, classicalSS...)
+	ikm = append(ikm, kyberSS...)
+	ikm = append(ikm, classicalCT...)
+	ikm = append(ikm, classicalPK...)
+
+	prk := hkdfExtract(nil, ikm)
+	return hkdfExpand(prk, []byte(hkdfInfo), sha256.Size)
+}
+
+// hkdfExtract implements the HKDF-Extract step (RFC 5869) using HMAC-SHA256.
+func hkdfExtract(salt, ikm []byte) []byte {
+	if salt == nil {
+		salt = make([]byte, sha256.Size)
+	}
+	mac := hmac.New(sha256.New, salt)
+	mac.Write(ikm)
+	return mac.Sum(nil)
+}
+
+// hkdfExpand implements the HKDF-Expand step (RFC 5869) using HMAC-SHA256.
+func hkdfExpand(prk, info []byte, length int) []byte {
+	var t, okm []byte
+	for counter := byte(1); len(okm) < length; counter++ {
+		mac := hmac.New(sha256.New, prk)
+		mac.Write(t)
+		mac.Write(info)
+		mac.Write([]byte{counter})
+		t = mac.Sum(nil)
+		okm = append(okm, t...)
+	}
+	return okm[:length]
+}
+
+// X25519Kyber768 combines X25519 with Kyber768.
+type X25519Kyber768 struct{}
+
+// NewX25519Kyber768 creates a new X25519Kyber768 instance.
+func NewX25519Kyber768() *X25519Kyber768 {
+	return &X25519Kyber768{}
+}
+
+// Key and ciphertext sizes for X25519Kyber768, so callers can pre-size
+// buffers without constructing an instance.
+const (
+	X25519Kyber768PublicKeyBytes    = 32 + pqc.Kyber768PublicKeyBytes
+	X25519Kyber768SecretKeyBytes    = 32 + pqc.Kyber768SecretKeyBytes
+	X25519Kyber768CiphertextBytes   = 32 + pqc.Kyber768CiphertextBytes
+	X25519Kyber768SharedSecretBytes = sha256.Size
+)
+
+// Keypair generates an X25519Kyber768 key pair.
+func (h *X25519Kyber768) Keypair() (publicKey, secretKey []byte, err error) {
+	classicalSK, err := ecdh.X25519().GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %v", ErrKeyGeneration, err)
+	}
+
+	kyberPK, kyberSK, err := pqc.NewKyber768().Keypair()
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %v", ErrKeyGeneration, err)
+	}
+
+	publicKey = append(append([]byte{}, classicalSK.PublicKey().Bytes()...), kyberPK...)
+	secretKey = append(append([]byte{}, classicalSK.Bytes()...), kyberSK...)
+
+	return publicKey, secretKey, nil
+}
+
+// Encapsulate encapsulates a shared secret using the hybrid public key.
+func (h *X25519Kyber768) Encapsulate(publicKey []byte) (ciphertext, sharedSecret []byte, err error) {
+	if err := validateKeyLength(publicKey, X25519Kyber768PublicKeyBytes, "public key"); err != nil {
+		return nil, nil, err
+	}
+
+	classicalPK := publicKey[:32]
+	kyberPK := publicKey[32:]
+
+	peerKey, err := ecdh.X25519().NewPublicKey(classicalPK)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %v", ErrEncapsulation, err)
+	}
+
+	ephemeralSK, err := ecdh.X25519().GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %v", ErrEncapsulation, err)
+	}
+
+	classicalSS, err := ephemeralSK.ECDH(peerKey)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %v", ErrEncapsulation, err)
+	}
+	classicalCT := ephemeralSK.PublicKey().Bytes()
+
+	kyberCT, kyberSS, err := pqc.NewKyber768().Encapsulate(kyberPK)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %v", ErrEncapsulation, err)
+	}
+
+	ciphertext = append(append([]byte{}, classicalCT...), kyberCT...)
+	sharedSecret = combine(classicalSS, kyberSS, classicalCT, classicalPK)
+
+	return ciphertext, sharedSecret, nil
+}
+
+// Decapsulate decapsulates the shared secret using the hybrid secret key.
+func (h *X25519Kyber768) Decapsulate(ciphertext, secretKey []byte) (sharedSecret []byte, err error) {
+	if err := validateKeyLength(ciphertext, X25519Kyber768CiphertextBytes, "ciphertext"); err != nil {
+		return nil, err
+	}
+	if err := validateKeyLength(secretKey, X25519Kyber768SecretKeyBytes, "secret key"); err != nil {
+		return nil, err
+	}
+
+	classicalCT := ciphertext[:32]
+	kyberCT := ciphertext[32:]
+
+	classicalSK := secretKey[:32]
+	kyberSK := secretKey[32:]
+
+	mySK, err := ecdh.X25519().NewPrivateKey(classicalSK)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrDecapsulation, err)
+	}
+
+	peerKey, err := ecdh.X25519().NewPublicKey(classicalCT)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrDecapsulation, err)
+	}
+
+	classicalSS, err := mySK.ECDH(peerKey)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrDecapsulation, err)
+	}
+
+	kyberSS, err := pqc.NewKyber768().Decapsulate(kyberCT, kyberSK)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrDecapsulation, err)
+	}
+
+	classicalPK := mySK.PublicKey().Bytes()
+
+	return combine(classicalSS, kyberSS, classicalCT, classicalPK), nil
+}
+
+// P256Kyber768 combines NIST P-256 ECDH with Kyber768.
+type P256Kyber768 struct{}
+
+// NewP256Kyber768 creates a new P256Kyber768 instance.
+func NewP256Kyber768() *P256Kyber768 {
+	return &P256Kyber768{}
+}
+
+// Key and ciphertext sizes for P256Kyber768, so callers can pre-size
+// buffers without constructing an instance.
+const (
+	p256PublicKeyBytes = 65 // uncompressed SEC1 point
+	p256SecretKeyBytes = 32
+
+	P256Kyber768PublicKeyBytes    = p256PublicKeyBytes + pqc.Kyber768PublicKeyBytes
+	P256Kyber768SecretKeyBytes    = p256SecretKeyBytes + pqc.Kyber768SecretKeyBytes
+	P256Kyber768CiphertextBytes   = p256PublicKeyBytes + pqc.Kyber768CiphertextBytes
+	P256Kyber768SharedSecretBytes = sha256.Size
+)
+
+// Keypair generates a P256Kyber768 key pair.
+func (h *P256Kyber768) Keypair() (publicKey, secretKey []byte, err error) {
+	classicalSK, err := ecdh.P256().GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %v", ErrKeyGeneration, err)
+	}
+
+	kyberPK, kyberSK, err := pqc.NewKyber768().Keypair()
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %v", ErrKeyGeneration, err)
+	}
+
+	publicKey = append(append([]byte{}, classicalSK.PublicKey().Bytes()...), kyberPK...)
+	secretKey = append(append([]byte{}, classicalSK.Bytes()...), kyberSK...)
+
+	return publicKey, secretKey, nil
+}
+
+// Encapsulate encapsulates a shared secret using the hybrid public key.
+func (h *P256Kyber768) Encapsulate(publicKey []byte) (ciphertext, sharedSecret []byte, err error) {
+	if err := validateKeyLength(publicKey, P256Kyber768PublicKeyBytes, "public key"); err != nil {
+		return nil, nil, err
+	}
+
+	classicalPK := publicKey[:p256PublicKeyBytes]
+	kyberPK := publicKey[p256PublicKeyBytes:]
+
+	peerKey, err := ecdh.P256().NewPublicKey(classicalPK)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %v", ErrEncapsulation, err)
+	}
+
+	ephemeralSK, err := ecdh.P256().GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %v", ErrEncapsulation, err)
+	}
+
+	classicalSS, err := ephemeralSK.ECDH(peerKey)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %v", ErrEncapsulation, err)
+	}
+	classicalCT := ephemeralSK.PublicKey().Bytes()
+
+	kyberCT, kyberSS, err := pqc.NewKyber768().Encapsulate(kyberPK)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %v", ErrEncapsulation, err)
+	}
+
+	ciphertext = append(append([]byte{}, classicalCT...), kyberCT...)
+	sharedSecret = combine(classicalSS, kyberSS, classicalCT, classicalPK)
+
+	return ciphertext, sharedSecret, nil
+}
+
+// Decapsulate decapsulates the shared secret using the hybrid secret key.
+func (h *P256Kyber768) Decapsulate(ciphertext, secretKey []byte) (sharedSecret []byte, err error) {
+	if err := validateKeyLength(ciphertext, P256Kyber768CiphertextBytes, "ciphertext"); err != nil {
+		return nil, err
+	}
+	if err := validateKeyLength(secretKey, P256Kyber768SecretKeyBytes, "secret key"); err != nil {
+		return nil, err
+	}
+
+	classicalCT := ciphertext[:p256PublicKeyBytes]
+	kyberCT := ciphertext[p256PublicKeyBytes:]
+
+	classicalSK := secretKey[:p256SecretKeyBytes]
+	kyberSK := secretKey[p256SecretKeyBytes:]
+
+	mySK, err := ecdh.P256().NewPrivateKey(classicalSK)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrDecapsulation, err)
+	}
+
+	peerKey, err := ecdh.P256().NewPublicKey(classicalCT)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrDecapsulation, err)
+	}
+
+	classicalSS, err := mySK.ECDH(peerKey)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrDecapsulation, err)
+	}
+
+	kyberSS, err := pqc.NewKyber768().Decapsulate(kyberCT, kyberSK)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrDecapsulation, err)
+	}
+
+	classicalPK := mySK.PublicKey().Bytes()
+
+	return combine(classicalSS, kyberSS, classicalCT, classicalPK), nil
+}
+
+// validateKeyLength validates that a key has the expected length.
+func validateKeyLength(key []byte, expectedLength int, keyType string) error {
+	if len(key) != expectedLength {
+		return fmt.Errorf("%w: %s must be exactly %d bytes, got %d bytes", ErrInvalidKeySize, keyType, expectedLength, len(key))
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+package hybrid
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestCombineIsDeterministic(t *testing.T) {
+	classicalSS := []byte("classical-shared-secret")
+	kyberSS := []byte("kyber-shared-secret")
+	classicalCT := []byte("classical-ciphertext")
+	classicalPK := []byte("classical-public-key")
+
+	a := combine(classicalSS, kyberSS, classicalCT, classicalPK)
+	b := combine(classicalSS, kyberSS, classicalCT, classicalPK)
+
+	if !bytes.Equal(a, b) {
+		t.Error("combine produced different output for identical inputs")
+	}
+}
+
+func TestCombineDiffersWhenAnyComponentChanges(t *testing.T) {
+	base := combine([]byte("classical-ss"), []byte("kyber-ss"), []byte("classical-ct"), []byte("classical-pk"))
+
+	variants := map[string][]byte{
+		"classicalSS": combine([]byte("different-ss"), []byte("kyber-ss"), []byte("classical-ct"), []byte("classical-pk")),
+		"kyberSS":     combine([]byte("classical-ss"), []byte("different-ss"), []byte("classical-ct"), []byte("classical-pk")),
+		"classicalCT": combine([]byte("classical-ss"), []byte("kyber-ss"), []byte("different-ct"), []byte("classical-pk")),
+		"classicalPK": combine([]byte("classical-ss"), []byte("kyber-ss"), []byte("classical-ct"), []byte("different-pk")),
+	}
+
+	for name, variant := range variants {
+		if bytes.Equal(base, variant) {
+			t.Errorf("combine did not change when %s changed", name)
+		}
+	}
+}
+
+func TestCombineOutputLength(t *testing.T) {
+	out := combine([]byte("a"), []byte("b"), []byte("c"), []byte("d"))
+	if len(out) != 32 {
+		t.Errorf("combine returned %d bytes, want 32", len(out))
+	}
+}
+
+func TestHKDFExtractIsDeterministic(t *testing.T) {
+	a := hkdfExtract([]byte("salt"), []byte("ikm"))
+	b := hkdfExtract([]byte("salt"), []byte("ikm"))
+	if !bytes.Equal(a, b) {
+		t.Error("hkdfExtract produced different output for identical inputs")
+	}
+}
+
+func TestHKDFExtractDefaultsSaltToZeros(t *testing.T) {
+	a := hkdfExtract(nil, []byte("ikm"))
+	b := hkdfExtract(make([]byte, 32), []byte("ikm"))
+	if !bytes.Equal(a, b) {
+		t.Error("hkdfExtract(nil, ikm) should match an explicit all-zero salt of the SHA-256 output length")
+	}
+}
+
+func TestHKDFExpandProducesRequestedLength(t *testing.T) {
+	prk := hkdfExtract(nil, []byte("ikm"))
+	for _, length := range []int{1, 16, 32, 100} {
+		out := hkdfExpand(prk, []byte(hkdfInfo), length)
+		if len(out) != length {
+			t.Errorf("hkdfExpand(length=%d) returned %d bytes", length, len(out))
+		}
+	}
+}
@@ -7,14 +7,20 @@ package pqc
 extern int PQCLEAN_KYBER512_CLEAN_crypto_kem_keypair(unsigned char *pk, unsigned char *sk);
 extern int PQCLEAN_KYBER512_CLEAN_crypto_kem_enc(unsigned char *ct, unsigned char *ss, const unsigned char *pk);
 extern int PQCLEAN_KYBER512_CLEAN_crypto_kem_dec(unsigned char *ss, const unsigned char *ct, const unsigned char *sk);
+extern int PQCLEAN_KYBER512_CLEAN_crypto_kem_keypair_derand(unsigned char *pk, unsigned char *sk, const unsigned char *coins);
+extern int PQCLEAN_KYBER512_CLEAN_crypto_kem_enc_derand(unsigned char *ct, unsigned char *ss, const unsigned char *pk, const unsigned char *coins);
 
 extern int PQCLEAN_KYBER768_CLEAN_crypto_kem_keypair(unsigned char *pk, unsigned char *sk);
 extern int PQCLEAN_KYBER768_CLEAN_crypto_kem_enc(unsigned char *ct, unsigned char *ss, const unsigned char *pk);
 extern int PQCLEAN_KYBER768_CLEAN_crypto_kem_dec(unsigned char *ss, const unsigned char *ct, const unsigned char *sk);
+extern int PQCLEAN_KYBER768_CLEAN_crypto_kem_keypair_derand(unsigned char *pk, unsigned char *sk, const unsigned char *coins);
+extern int PQCLEAN_KYBER768_CLEAN_crypto_kem_enc_derand(unsigned char *ct, unsigned char *ss, const unsigned char *pk, const unsigned char *coins);
 
 extern int PQCLEAN_KYBER1024_CLEAN_crypto_kem_keypair(unsigned char *pk, unsigned char *sk);
 extern int PQCLEAN_KYBER1024_CLEAN_crypto_kem_enc(unsigned char *ct, unsigned char *ss, const unsigned char *pk);
 extern int PQCLEAN_KYBER1024_CLEAN_crypto_kem_dec(unsigned char *ss, const unsigned char *ct, const unsigned char *sk);
+extern int PQCLEAN_KYBER1024_CLEAN_crypto_kem_keypair_derand(unsigned char *pk, unsigned char *sk, const unsigned char *coins);
+extern int PQCLEAN_KYBER1024_CLEAN_crypto_kem_enc_derand(unsigned char *ct, unsigned char *ss, const unsigned char *pk, const unsigned char *coins);
 */
 import "C"
 
@@ -106,6 +112,106 @@ func (k *Kyber512) Decapsulate(ciphertext, secretKey []byte) (sharedSecret []byt
 	return sharedSecret, nil
 }
 
+// KeypairSecure generates a Kyber512 key pair whose secret key is held
+// in an mlock'd, zeroize-on-drop SecretKey handle instead of a plain
+// []byte.
+func (k *Kyber512) KeypairSecure() (publicKey []byte, secretKey *SecretKey, err error) {
+	publicKey, raw, err := k.Keypair()
+	if err != nil {
+		return nil, nil, err
+	}
+	secretKey, err = newSecretKey(raw)
+	for i := range raw {
+		raw[i] = 0
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	return publicKey, secretKey, nil
+}
+
+// DecapsulateSecure decapsulates the shared secret using a secret key
+// held in a SecretKey handle.
+func (k *Kyber512) DecapsulateSecure(ciphertext []byte, secretKey *SecretKey) (sharedSecret []byte, err error) {
+	err = secretKey.withBytes(func(raw []byte) error {
+		var decErr error
+		sharedSecret, decErr = k.Decapsulate(ciphertext, raw)
+		return decErr
+	})
+	return sharedSecret, err
+}
+
+// Name returns the canonical algorithm name, satisfying the Scheme
+// interface.
+func (k *Kyber512) Name() string { return "Kyber512" }
+
+// PublicKeySize returns the public key size in bytes.
+func (k *Kyber512) PublicKeySize() int { return Kyber512PublicKeyBytes }
+
+// CiphertextSize returns the ciphertext size in bytes.
+func (k *Kyber512) CiphertextSize() int { return Kyber512CiphertextBytes }
+
+// KeypairFromSeed deterministically generates a Kyber512 key pair from a
+// caller-supplied seed, using the PQClean `_derand` entry point. The same
+// seed always yields the same key pair, which is useful for KAT vectors
+// and reproducible test fixtures.
+func (k *Kyber512) KeypairFromSeed(seed []byte) (publicKey, secretKey []byte, err error) {
+	if libraryPath == "" {
+		return nil, nil, ErrLibraryNotFound
+	}
+
+	if err := validateKeyLength(seed, Kyber512SeedBytes, "seed"); err != nil {
+		return nil, nil, err
+	}
+
+	publicKey = make([]byte, Kyber512PublicKeyBytes)
+	secretKey = make([]byte, Kyber512SecretKeyBytes)
+
+	result := C.PQCLEAN_KYBER512_CLEAN_crypto_kem_keypair_derand(
+		(*C.uchar)(unsafe.Pointer(&publicKey[0])),
+		(*C.uchar)(unsafe.Pointer(&secretKey[0])),
+		(*C.uchar)(unsafe.Pointer(&seed[0])),
+	)
+
+	if result != 0 {
+		return nil, nil, fmt.Errorf("%w: code %d", ErrKeyGeneration, result)
+	}
+
+	return publicKey, secretKey, nil
+}
+
+// EncapsulateDeterministic encapsulates a shared secret using the public
+// key and caller-supplied encapsulation coins, using the PQClean
+// `_derand` entry point.
+func (k *Kyber512) EncapsulateDeterministic(publicKey, coins []byte) (ciphertext, sharedSecret []byte, err error) {
+	if libraryPath == "" {
+		return nil, nil, ErrLibraryNotFound
+	}
+
+	if err := validateKeyLength(publicKey, Kyber512PublicKeyBytes, "public key"); err != nil {
+		return nil, nil, err
+	}
+	if err := validateKeyLength(coins, Kyber512EncapsulationCoinsBytes, "coins"); err != nil {
+		return nil, nil, err
+	}
+
+	ciphertext = make([]byte, Kyber512CiphertextBytes)
+	sharedSecret = make([]byte, Kyber512SharedSecretBytes)
+
+	result := C.PQCLEAN_KYBER512_CLEAN_crypto_kem_enc_derand(
+		(*C.uchar)(unsafe.Pointer(&ciphertext[0])),
+		(*C.uchar)(unsafe.Pointer(&sharedSecret[0])),
+		(*C.uchar)(unsafe.Pointer(&publicKey[0])),
+		(*C.uchar)(unsafe.Pointer(&coins[0])),
+	)
+
+	if result != 0 {
+		return nil, nil, fmt.Errorf("%w: code %d", ErrEncapsulation, result)
+	}
+
+	return ciphertext, sharedSecret, nil
+}
+
 // Kyber768 provides Kyber-768 key encapsulation mechanism
 type Kyber768 struct{}
 
@@ -189,6 +295,106 @@ func (k *Kyber768) Decapsulate(ciphertext, secretKey []byte) (sharedSecret []byt
 	return sharedSecret, nil
 }
 
+// KeypairSecure generates a Kyber768 key pair whose secret key is held
+// in an mlock'd, zeroize-on-drop SecretKey handle instead of a plain
+// []byte.
+func (k *Kyber768) KeypairSecure() (publicKey []byte, secretKey *SecretKey, err error) {
+	publicKey, raw, err := k.Keypair()
+	if err != nil {
+		return nil, nil, err
+	}
+	secretKey, err = newSecretKey(raw)
+	for i := range raw {
+		raw[i] = 0
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	return publicKey, secretKey, nil
+}
+
+// DecapsulateSecure decapsulates the shared secret using a secret key
+// held in a SecretKey handle.
+func (k *Kyber768) DecapsulateSecure(ciphertext []byte, secretKey *SecretKey) (sharedSecret []byte, err error) {
+	err = secretKey.withBytes(func(raw []byte) error {
+		var decErr error
+		sharedSecret, decErr = k.Decapsulate(ciphertext, raw)
+		return decErr
+	})
+	return sharedSecret, err
+}
+
+// Name returns the canonical algorithm name, satisfying the Scheme
+// interface.
+func (k *Kyber768) Name() string { return "Kyber768" }
+
+// PublicKeySize returns the public key size in bytes.
+func (k *Kyber768) PublicKeySize() int { return Kyber768PublicKeyBytes }
+
+// CiphertextSize returns the ciphertext size in bytes.
+func (k *Kyber768) CiphertextSize() int { return Kyber768CiphertextBytes }
+
+// KeypairFromSeed deterministically generates a Kyber768 key pair from a
+// caller-supplied seed, using the PQClean `_derand` entry point. The same
+// seed always yields the same key pair, which is useful for KAT vectors
+// and reproducible test fixtures.
+func (k *Kyber768) KeypairFromSeed(seed []byte) (publicKey, secretKey []byte, err error) {
+	if libraryPath == "" {
+		return nil, nil, ErrLibraryNotFound
+	}
+
+	if err := validateKeyLength(seed, Kyber768SeedBytes, "seed"); err != nil {
+		return nil, nil, err
+	}
+
+	publicKey = make([]byte, Kyber768PublicKeyBytes)
+	secretKey = make([]byte, Kyber768SecretKeyBytes)
+
+	result := C.PQCLEAN_KYBER768_CLEAN_crypto_kem_keypair_derand(
+		(*C.uchar)(unsafe.Pointer(&publicKey[0])),
+		(*C.uchar)(unsafe.Pointer(&secretKey[0])),
+		(*C.uchar)(unsafe.Pointer(&seed[0])),
+	)
+
+	if result != 0 {
+		return nil, nil, fmt.Errorf("%w: code %d", ErrKeyGeneration, result)
+	}
+
+	return publicKey, secretKey, nil
+}
+
+// EncapsulateDeterministic encapsulates a shared secret using the public
+// key and caller-supplied encapsulation coins, using the PQClean
+// `_derand` entry point.
+func (k *Kyber768) EncapsulateDeterministic(publicKey, coins []byte) (ciphertext, sharedSecret []byte, err error) {
+	if libraryPath == "" {
+		return nil, nil, ErrLibraryNotFound
+	}
+
+	if err := validateKeyLength(publicKey, Kyber768PublicKeyBytes, "public key"); err != nil {
+		return nil, nil, err
+	}
+	if err := validateKeyLength(coins, Kyber768EncapsulationCoinsBytes, "coins"); err != nil {
+		return nil, nil, err
+	}
+
+	ciphertext = make([]byte, Kyber768CiphertextBytes)
+	sharedSecret = make([]byte, Kyber768SharedSecretBytes)
+
+	result := C.PQCLEAN_KYBER768_CLEAN_crypto_kem_enc_derand(
+		(*C.uchar)(unsafe.Pointer(&ciphertext[0])),
+		(*C.uchar)(unsafe.Pointer(&sharedSecret[0])),
+		(*C.uchar)(unsafe.Pointer(&publicKey[0])),
+		(*C.uchar)(unsafe.Pointer(&coins[0])),
+	)
+
+	if result != 0 {
+		return nil, nil, fmt.Errorf("%w: code %d", ErrEncapsulation, result)
+	}
+
+	return ciphertext, sharedSecret, nil
+}
+
 // Kyber1024 provides Kyber-1024 key encapsulation mechanism
 type Kyber1024 struct{}
 
@@ -272,7 +478,107 @@ func (k *Kyber1024) Decapsulate(ciphertext, secretKey []byte) (sharedSecret []by
 	return sharedSecret, nil
 }
 
+// KeypairSecure generates a Kyber1024 key pair whose secret key is held
+// in an mlock'd, zeroize-on-drop SecretKey handle instead of a plain
+// []byte.
+func (k *Kyber1024) KeypairSecure() (publicKey []byte, secretKey *SecretKey, err error) {
+	publicKey, raw, err := k.Keypair()
+	if err != nil {
+		return nil, nil, err
+	}
+	secretKey, err = newSecretKey(raw)
+	for i := range raw {
+		raw[i] = 0
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	return publicKey, secretKey, nil
+}
+
+// DecapsulateSecure decapsulates the shared secret using a secret key
+// held in a SecretKey handle.
+func (k *Kyber1024) DecapsulateSecure(ciphertext []byte, secretKey *SecretKey) (sharedSecret []byte, err error) {
+	err = secretKey.withBytes(func(raw []byte) error {
+		var decErr error
+		sharedSecret, decErr = k.Decapsulate(ciphertext, raw)
+		return decErr
+	})
+	return sharedSecret, err
+}
+
+// Name returns the canonical algorithm name, satisfying the Scheme
+// interface.
+func (k *Kyber1024) Name() string { return "Kyber1024" }
+
+// PublicKeySize returns the public key size in bytes.
+func (k *Kyber1024) PublicKeySize() int { return Kyber1024PublicKeyBytes }
+
+// CiphertextSize returns the ciphertext size in bytes.
+func (k *Kyber1024) CiphertextSize() int { return Kyber1024CiphertextBytes }
+
+// KeypairFromSeed deterministically generates a Kyber1024 key pair from a
+// caller-supplied seed, using the PQClean `_derand` entry point. The same
+// seed always yields the same key pair, which is useful for KAT vectors
+// and reproducible test fixtures.
+func (k *Kyber1024) KeypairFromSeed(seed []byte) (publicKey, secretKey []byte, err error) {
+	if libraryPath == "" {
+		return nil, nil, ErrLibraryNotFound
+	}
+
+	if err := validateKeyLength(seed, Kyber1024SeedBytes, "seed"); err != nil {
+		return nil, nil, err
+	}
+
+	publicKey = make([]byte, Kyber1024PublicKeyBytes)
+	secretKey = make([]byte, Kyber1024SecretKeyBytes)
+
+	result := C.PQCLEAN_KYBER1024_CLEAN_crypto_kem_keypair_derand(
+		(*C.uchar)(unsafe.Pointer(&publicKey[0])),
+		(*C.uchar)(unsafe.Pointer(&secretKey[0])),
+		(*C.uchar)(unsafe.Pointer(&seed[0])),
+	)
+
+	if result != 0 {
+		return nil, nil, fmt.Errorf("%w: code %d", ErrKeyGeneration, result)
+	}
+
+	return publicKey, secretKey, nil
+}
+
+// EncapsulateDeterministic encapsulates a shared secret using the public
+// key and caller-supplied encapsulation coins, using the PQClean
+// `_derand` entry point.
+func (k *Kyber1024) EncapsulateDeterministic(publicKey, coins []byte) (ciphertext, sharedSecret []byte, err error) {
+	if libraryPath == "" {
+		return nil, nil, ErrLibraryNotFound
+	}
+
+	if err := validateKeyLength(publicKey, Kyber1024PublicKeyBytes, "public key"); err != nil {
+		return nil, nil, err
+	}
+	if err := validateKeyLength(coins, Kyber1024EncapsulationCoinsBytes, "coins"); err != nil {
+		return nil, nil, err
+	}
+
+	ciphertext = make([]byte, Kyber1024CiphertextBytes)
+	sharedSecret = make([]byte, Kyber1024SharedSecretBytes)
+
+	result := C.PQCLEAN_KYBER1024_CLEAN_crypto_kem_enc_derand(
+		(*C.uchar)(unsafe.Pointer(&ciphertext[0])),
+		(*C.uchar)(unsafe.Pointer(&sharedSecret[0])),
+		(*C.uchar)(unsafe.Pointer(&publicKey[0])),
+		(*C.uchar)(unsafe.Pointer(&coins[0])),
+	)
+
+	if result != 0 {
+		return nil, nil, fmt.Errorf("%w: code %d", ErrEncapsulation, result)
+	}
+
+	return ciphertext, sharedSecret, nil
+}
+
 // NewKyber creates a new Kyber768 instance (default)
 func NewKyber() *Kyber768 {
 	return NewKyber768()
-}
\ No newline at end of file
+}
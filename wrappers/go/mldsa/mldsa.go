@@ -0,0 +1,272 @@
+// Package mldsa provides Go bindings for ML-DSA (FIPS 204), the NIST
+// standardized version of Dilithium. ML-DSA uses the same lattice
+// construction as round-3 Dilithium but different encoding and key/
+// signature sizes, so it is bound here as a genuine parallel
+// implementation rather than an alias of pqc.Dilithium2 et al.
+package mldsa
+
+/*
+#cgo CFLAGS: -I.
+#cgo LDFLAGS: -L. -lpqc
+
+extern int PQCLEAN_MLDSA44_CLEAN_crypto_sign_keypair(unsigned char *pk, unsigned char *sk);
+extern int PQCLEAN_MLDSA44_CLEAN_crypto_sign_signature(unsigned char *sig, size_t *siglen, const unsigned char *m, size_t mlen, const unsigned char *sk);
+extern int PQCLEAN_MLDSA44_CLEAN_crypto_sign_verify(const unsigned char *sig, size_t siglen, const unsigned char *m, size_t mlen, const unsigned char *pk);
+
+extern int PQCLEAN_MLDSA65_CLEAN_crypto_sign_keypair(unsigned char *pk, unsigned char *sk);
+extern int PQCLEAN_MLDSA65_CLEAN_crypto_sign_signature(unsigned char *sig, size_t *siglen, const unsigned char *m, size_t mlen, const unsigned char *sk);
+extern int PQCLEAN_MLDSA65_CLEAN_crypto_sign_verify(const unsigned char *sig, size_t siglen, const unsigned char *m, size_t mlen, const unsigned char *pk);
+
+extern int PQCLEAN_MLDSA87_CLEAN_crypto_sign_keypair(unsigned char *pk, unsigned char *sk);
+extern int PQCLEAN_MLDSA87_CLEAN_crypto_sign_signature(unsigned char *sig, size_t *siglen, const unsigned char *m, size_t mlen, const unsigned char *sk);
+extern int PQCLEAN_MLDSA87_CLEAN_crypto_sign_verify(const unsigned char *sig, size_t siglen, const unsigned char *m, size_t mlen, const unsigned char *pk);
+*/
+import "C"
+
+import (
+	"fmt"
+	"unsafe"
+
+	pqc "github.com/QudsLab/PQChub/wrappers/go"
+)
+
+// cBytesOrNil returns a pointer to the first byte of b, or nil for an
+// empty slice, so zero-length inputs can be passed to cgo without an
+// out-of-range index.
+func cBytesOrNil(b []byte) *C.uchar {
+	if len(b) == 0 {
+		return nil
+	}
+	return (*C.uchar)(unsafe.Pointer(&b[0]))
+}
+
+// Key and signature sizes for each ML-DSA parameter set.
+const (
+	MLDsa44PublicKeyBytes = 1312
+	MLDsa44SecretKeyBytes = 2560
+	MLDsa44SignatureBytes = 2420
+
+	MLDsa65PublicKeyBytes = 1952
+	MLDsa65SecretKeyBytes = 4032
+	MLDsa65SignatureBytes = 3309
+
+	MLDsa87PublicKeyBytes = 2592
+	MLDsa87SecretKeyBytes = 4896
+	MLDsa87SignatureBytes = 4627
+)
+
+// MLDsa44 provides ML-DSA-44 digital signatures.
+type MLDsa44 struct{}
+
+// NewMLDsa44 creates a new MLDsa44 instance.
+func NewMLDsa44() *MLDsa44 { return &MLDsa44{} }
+
+// Keypair generates an ML-DSA-44 key pair.
+func (d *MLDsa44) Keypair() (publicKey, secretKey []byte, err error) {
+	if !pqc.LibraryAvailable() {
+		return nil, nil, pqc.ErrLibraryNotFound
+	}
+
+	publicKey = make([]byte, MLDsa44PublicKeyBytes)
+	secretKey = make([]byte, MLDsa44SecretKeyBytes)
+
+	result := C.PQCLEAN_MLDSA44_CLEAN_crypto_sign_keypair(
+		(*C.uchar)(unsafe.Pointer(&publicKey[0])),
+		(*C.uchar)(unsafe.Pointer(&secretKey[0])),
+	)
+	if result != 0 {
+		return nil, nil, fmt.Errorf("%w: code %d", pqc.ErrKeyGeneration, result)
+	}
+
+	return publicKey, secretKey, nil
+}
+
+// Sign signs a message using the secret key.
+func (d *MLDsa44) Sign(message, secretKey []byte) (signature []byte, err error) {
+	if !pqc.LibraryAvailable() {
+		return nil, pqc.ErrLibraryNotFound
+	}
+	if len(secretKey) != MLDsa44SecretKeyBytes {
+		return nil, fmt.Errorf("%w: secret key must be exactly %d bytes, got %d bytes", pqc.ErrInvalidKeySize, MLDsa44SecretKeyBytes, len(secretKey))
+	}
+
+	signature = make([]byte, MLDsa44SignatureBytes)
+	sigLen := C.size_t(len(signature))
+
+	result := C.PQCLEAN_MLDSA44_CLEAN_crypto_sign_signature(
+		(*C.uchar)(unsafe.Pointer(&signature[0])),
+		&sigLen,
+		cBytesOrNil(message),
+		C.size_t(len(message)),
+		(*C.uchar)(unsafe.Pointer(&secretKey[0])),
+	)
+	if result != 0 {
+		return nil, fmt.Errorf("%w: code %d", pqc.ErrSigning, result)
+	}
+
+	return signature[:sigLen], nil
+}
+
+// Verify verifies a signature against a message using the public key.
+func (d *MLDsa44) Verify(message, signature, publicKey []byte) (valid bool, err error) {
+	if !pqc.LibraryAvailable() {
+		return false, pqc.ErrLibraryNotFound
+	}
+	if len(publicKey) != MLDsa44PublicKeyBytes {
+		return false, fmt.Errorf("%w: public key must be exactly %d bytes, got %d bytes", pqc.ErrInvalidKeySize, MLDsa44PublicKeyBytes, len(publicKey))
+	}
+
+	result := C.PQCLEAN_MLDSA44_CLEAN_crypto_sign_verify(
+		cBytesOrNil(signature),
+		C.size_t(len(signature)),
+		cBytesOrNil(message),
+		C.size_t(len(message)),
+		(*C.uchar)(unsafe.Pointer(&publicKey[0])),
+	)
+
+	return result == 0, nil
+}
+
+// MLDsa65 provides ML-DSA-65 digital signatures.
+type MLDsa65 struct{}
+
+// NewMLDsa65 creates a new MLDsa65 instance.
+func NewMLDsa65() *MLDsa65 { return &MLDsa65{} }
+
+// Keypair generates an ML-DSA-65 key pair.
+func (d *MLDsa65) Keypair() (publicKey, secretKey []byte, err error) {
+	if !pqc.LibraryAvailable() {
+		return nil, nil, pqc.ErrLibraryNotFound
+	}
+
+	publicKey = make([]byte, MLDsa65PublicKeyBytes)
+	secretKey = make([]byte, MLDsa65SecretKeyBytes)
+
+	result := C.PQCLEAN_MLDSA65_CLEAN_crypto_sign_keypair(
+		(*C.uchar)(unsafe.Pointer(&publicKey[0])),
+		(*C.uchar)(unsafe.Pointer(&secretKey[0])),
+	)
+	if result != 0 {
+		return nil, nil, fmt.Errorf("%w: code %d", pqc.ErrKeyGeneration, result)
+	}
+
+	return publicKey, secretKey, nil
+}
+
+// Sign signs a message using the secret key.
+func (d *MLDsa65) Sign(message, secretKey []byte) (signature []byte, err error) {
+	if !pqc.LibraryAvailable() {
+		return nil, pqc.ErrLibraryNotFound
+	}
+	if len(secretKey) != MLDsa65SecretKeyBytes {
+		return nil, fmt.Errorf("%w: secret key must be exactly %d bytes, got %d bytes", pqc.ErrInvalidKeySize, MLDsa65SecretKeyBytes, len(secretKey))
+	}
+
+	signature = make([]byte, MLDsa65SignatureBytes)
+	sigLen := C.size_t(len(signature))
+
+	result := C.PQCLEAN_MLDSA65_CLEAN_crypto_sign_signature(
+		(*C.uchar)(unsafe.Pointer(&signature[0])),
+		&sigLen,
+		cBytesOrNil(message),
+		C.size_t(len(message)),
+		(*C.uchar)(unsafe.Pointer(&secretKey[0])),
+	)
+	if result != 0 {
+		return nil, fmt.Errorf("%w: code %d", pqc.ErrSigning, result)
+	}
+
+	return signature[:sigLen], nil
+}
+
+// Verify verifies a signature against a message using the public key.
+func (d *MLDsa65) Verify(message, signature, publicKey []byte) (valid bool, err error) {
+	if !pqc.LibraryAvailable() {
+		return false, pqc.ErrLibraryNotFound
+	}
+	if len(publicKey) != MLDsa65PublicKeyBytes {
+		return false, fmt.Errorf("%w: public key must be exactly %d bytes, got %d bytes", pqc.ErrInvalidKeySize, MLDsa65PublicKeyBytes, len(publicKey))
+	}
+
+	result := C.PQCLEAN_MLDSA65_CLEAN_crypto_sign_verify(
+		cBytesOrNil(signature),
+		C.size_t(len(signature)),
+		cBytesOrNil(message),
+		C.size_t(len(message)),
+		(*C.uchar)(unsafe.Pointer(&publicKey[0])),
+	)
+
+	return result == 0, nil
+}
+
+// MLDsa87 provides ML-DSA-87 digital signatures.
+type MLDsa87 struct{}
+
+// NewMLDsa87 creates a new MLDsa87 instance.
+func NewMLDsa87() *MLDsa87 { return &MLDsa87{} }
+
+// Keypair generates an ML-DSA-87 key pair.
+func (d *MLDsa87) Keypair() (publicKey, secretKey []byte, err error) {
+	if !pqc.LibraryAvailable() {
+		return nil, nil, pqc.ErrLibraryNotFound
+	}
+
+	publicKey = make([]byte, MLDsa87PublicKeyBytes)
+	secretKey = make([]byte, MLDsa87SecretKeyBytes)
+
+	result := C.PQCLEAN_MLDSA87_CLEAN_crypto_sign_keypair(
+		(*C.uchar)(unsafe.Pointer(&publicKey[0])),
+		(*C.uchar)(unsafe.Pointer(&secretKey[0])),
+	)
+	if result != 0 {
+		return nil, nil, fmt.Errorf("%w: code %d", pqc.ErrKeyGeneration, result)
+	}
+
+	return publicKey, secretKey, nil
+}
+
+// Sign signs a message using the secret key.
+func (d *MLDsa87) Sign(message, secretKey []byte) (signature []byte, err error) {
+	if !pqc.LibraryAvailable() {
+		return nil, pqc.ErrLibraryNotFound
+	}
+	if len(secretKey) != MLDsa87SecretKeyBytes {
+		return nil, fmt.Errorf("%w: secret key must be exactly %d bytes, got %d bytes", pqc.ErrInvalidKeySize, MLDsa87SecretKeyBytes, len(secretKey))
+	}
+
+	signature = make([]byte, MLDsa87SignatureBytes)
+	sigLen := C.size_t(len(signature))
+
+	result := C.PQCLEAN_MLDSA87_CLEAN_crypto_sign_signature(
+		(*C.uchar)(unsafe.Pointer(&signature[0])),
+		&sigLen,
+		cBytesOrNil(message),
+		C.size_t(len(message)),
+		(*C.uchar)(unsafe.Pointer(&secretKey[0])),
+	)
+	if result != 0 {
+		return nil, fmt.Errorf("%w: code %d", pqc.ErrSigning, result)
+	}
+
+	return signature[:sigLen], nil
+}
+
+// Verify verifies a signature against a message using the public key.
+func (d *MLDsa87) Verify(message, signature, publicKey []byte) (valid bool, err error) {
+	if !pqc.LibraryAvailable() {
+		return false, pqc.ErrLibraryNotFound
+	}
+	if len(publicKey) != MLDsa87PublicKeyBytes {
+		return false, fmt.Errorf("%w: public key must be exactly %d bytes, got %d bytes", pqc.ErrInvalidKeySize, MLDsa87PublicKeyBytes, len(publicKey))
+	}
+
+	result := C.PQCLEAN_MLDSA87_CLEAN_crypto_sign_verify(
+		cBytesOrNil(signature),
+		C.size_t(len(signature)),
+		cBytesOrNil(message),
+		C.size_t(len(message)),
+		(*C.uchar)(unsafe.Pointer(&publicKey[0])),
+	)
+
+	return result == 0, nil
+}
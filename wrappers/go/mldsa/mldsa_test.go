@@ -0,0 +1,85 @@
+package mldsa
+
+import (
+	"errors"
+	"testing"
+
+	pqc "github.com/QudsLab/PQChub/wrappers/go"
+)
+
+type mldsaScheme interface {
+	Keypair() (publicKey, secretKey []byte, err error)
+	Sign(message, secretKey []byte) (signature []byte, err error)
+	Verify(message, signature, publicKey []byte) (valid bool, err error)
+}
+
+func TestMLDsaSignVerifyRoundTrip(t *testing.T) {
+	if !pqc.LibraryAvailable() {
+		t.Skip("PQC library not found")
+	}
+
+	schemes := map[string]mldsaScheme{
+		"ML-DSA-44": NewMLDsa44(),
+		"ML-DSA-65": NewMLDsa65(),
+		"ML-DSA-87": NewMLDsa87(),
+	}
+	for name, scheme := range schemes {
+		scheme := scheme
+		t.Run(name, func(t *testing.T) {
+			publicKey, secretKey, err := scheme.Keypair()
+			if err != nil {
+				t.Fatalf("Keypair: %v", err)
+			}
+
+			message := []byte("ML-DSA round-trip message")
+			signature, err := scheme.Sign(message, secretKey)
+			if err != nil {
+				t.Fatalf("Sign: %v", err)
+			}
+
+			valid, err := scheme.Verify(message, signature, publicKey)
+			if err != nil {
+				t.Fatalf("Verify: %v", err)
+			}
+			if !valid {
+				t.Error("Verify rejected a signature produced by Sign for the same key")
+			}
+		})
+	}
+}
+
+func TestMLDsaVerifyRejectsTamperedMessage(t *testing.T) {
+	if !pqc.LibraryAvailable() {
+		t.Skip("PQC library not found")
+	}
+
+	dsa := NewMLDsa65()
+	publicKey, secretKey, err := dsa.Keypair()
+	if err != nil {
+		t.Fatalf("Keypair: %v", err)
+	}
+
+	signature, err := dsa.Sign([]byte("original message"), secretKey)
+	if err != nil {
+		t.Fatalf("Sign: %v", err)
+	}
+
+	valid, err := dsa.Verify([]byte("tampered message"), signature, publicKey)
+	if err != nil {
+		t.Fatalf("Verify: %v", err)
+	}
+	if valid {
+		t.Error("Verify accepted a signature over a different message")
+	}
+}
+
+func TestMLDsaSignRejectsWrongLengthSecretKey(t *testing.T) {
+	if !pqc.LibraryAvailable() {
+		t.Skip("PQC library not found")
+	}
+
+	dsa := NewMLDsa44()
+	if _, err := dsa.Sign([]byte("message"), make([]byte, MLDsa44SecretKeyBytes-1)); !errors.Is(err, pqc.ErrInvalidKeySize) {
+		t.Errorf("got err = %v, want wrapping ErrInvalidKeySize", err)
+	}
+}
@@ -0,0 +1,310 @@
+// Package mlkem provides Go bindings for ML-KEM (FIPS 203), the NIST
+// standardized version of Kyber. ML-KEM shares its round-3 Kyber
+// ancestor's parameter sizes but is a distinct algorithm (different FO
+// transform and domain separation), so it is bound here as a genuine
+// parallel implementation rather than an alias of pqc.Kyber768 et al.
+package mlkem
+
+/*
+#cgo CFLAGS: -I.
+#cgo LDFLAGS: -L. -lpqc
+
+extern int PQCLEAN_MLKEM512_CLEAN_crypto_kem_keypair(unsigned char *pk, unsigned char *sk);
+extern int PQCLEAN_MLKEM512_CLEAN_crypto_kem_enc(unsigned char *ct, unsigned char *ss, const unsigned char *pk);
+extern int PQCLEAN_MLKEM512_CLEAN_crypto_kem_dec(unsigned char *ss, const unsigned char *ct, const unsigned char *sk);
+
+extern int PQCLEAN_MLKEM768_CLEAN_crypto_kem_keypair(unsigned char *pk, unsigned char *sk);
+extern int PQCLEAN_MLKEM768_CLEAN_crypto_kem_enc(unsigned char *ct, unsigned char *ss, const unsigned char *pk);
+extern int PQCLEAN_MLKEM768_CLEAN_crypto_kem_dec(unsigned char *ss, const unsigned char *ct, const unsigned char *sk);
+
+extern int PQCLEAN_MLKEM1024_CLEAN_crypto_kem_keypair(unsigned char *pk, unsigned char *sk);
+extern int PQCLEAN_MLKEM1024_CLEAN_crypto_kem_enc(unsigned char *ct, unsigned char *ss, const unsigned char *pk);
+extern int PQCLEAN_MLKEM1024_CLEAN_crypto_kem_dec(unsigned char *ss, const unsigned char *ct, const unsigned char *sk);
+*/
+import "C"
+
+import (
+	"fmt"
+	"unsafe"
+
+	pqc "github.com/QudsLab/PQChub/wrappers/go"
+)
+
+// Key, ciphertext and shared secret sizes for each ML-KEM parameter set.
+const (
+	MLKem512PublicKeyBytes    = 800
+	MLKem512SecretKeyBytes    = 1632
+	MLKem512CiphertextBytes   = 768
+	MLKem512SharedSecretBytes = 32
+
+	MLKem768PublicKeyBytes    = 1184
+	MLKem768SecretKeyBytes    = 2400
+	MLKem768CiphertextBytes   = 1088
+	MLKem768SharedSecretBytes = 32
+
+	MLKem1024PublicKeyBytes    = 1568
+	MLKem1024SecretKeyBytes    = 3168
+	MLKem1024CiphertextBytes   = 1568
+	MLKem1024SharedSecretBytes = 32
+)
+
+func init() {
+	pqc.RegisterScheme(NewMLKem512())
+	pqc.RegisterScheme(NewMLKem768())
+	pqc.RegisterScheme(NewMLKem1024())
+}
+
+// MLKem512 provides the ML-KEM-512 key encapsulation mechanism.
+type MLKem512 struct{}
+
+// NewMLKem512 creates a new MLKem512 instance.
+func NewMLKem512() *MLKem512 { return &MLKem512{} }
+
+// Name returns the canonical algorithm name, satisfying pqc.Scheme.
+func (k *MLKem512) Name() string { return "ML-KEM-512" }
+
+// PublicKeySize returns the public key size in bytes.
+func (k *MLKem512) PublicKeySize() int { return MLKem512PublicKeyBytes }
+
+// CiphertextSize returns the ciphertext size in bytes.
+func (k *MLKem512) CiphertextSize() int { return MLKem512CiphertextBytes }
+
+// Keypair generates an ML-KEM-512 key pair.
+func (k *MLKem512) Keypair() (publicKey, secretKey []byte, err error) {
+	if !pqc.LibraryAvailable() {
+		return nil, nil, pqc.ErrLibraryNotFound
+	}
+
+	publicKey = make([]byte, MLKem512PublicKeyBytes)
+	secretKey = make([]byte, MLKem512SecretKeyBytes)
+
+	result := C.PQCLEAN_MLKEM512_CLEAN_crypto_kem_keypair(
+		(*C.uchar)(unsafe.Pointer(&publicKey[0])),
+		(*C.uchar)(unsafe.Pointer(&secretKey[0])),
+	)
+	if result != 0 {
+		return nil, nil, fmt.Errorf("%w: code %d", pqc.ErrKeyGeneration, result)
+	}
+
+	return publicKey, secretKey, nil
+}
+
+// Encapsulate encapsulates a shared secret using the public key.
+func (k *MLKem512) Encapsulate(publicKey []byte) (ciphertext, sharedSecret []byte, err error) {
+	if !pqc.LibraryAvailable() {
+		return nil, nil, pqc.ErrLibraryNotFound
+	}
+	if len(publicKey) != MLKem512PublicKeyBytes {
+		return nil, nil, fmt.Errorf("%w: public key must be exactly %d bytes, got %d bytes", pqc.ErrInvalidKeySize, MLKem512PublicKeyBytes, len(publicKey))
+	}
+
+	ciphertext = make([]byte, MLKem512CiphertextBytes)
+	sharedSecret = make([]byte, MLKem512SharedSecretBytes)
+
+	result := C.PQCLEAN_MLKEM512_CLEAN_crypto_kem_enc(
+		(*C.uchar)(unsafe.Pointer(&ciphertext[0])),
+		(*C.uchar)(unsafe.Pointer(&sharedSecret[0])),
+		(*C.uchar)(unsafe.Pointer(&publicKey[0])),
+	)
+	if result != 0 {
+		return nil, nil, fmt.Errorf("%w: code %d", pqc.ErrEncapsulation, result)
+	}
+
+	return ciphertext, sharedSecret, nil
+}
+
+// Decapsulate decapsulates the shared secret using the secret key.
+func (k *MLKem512) Decapsulate(ciphertext, secretKey []byte) (sharedSecret []byte, err error) {
+	if !pqc.LibraryAvailable() {
+		return nil, pqc.ErrLibraryNotFound
+	}
+	if len(ciphertext) != MLKem512CiphertextBytes {
+		return nil, fmt.Errorf("%w: ciphertext must be exactly %d bytes, got %d bytes", pqc.ErrInvalidKeySize, MLKem512CiphertextBytes, len(ciphertext))
+	}
+	if len(secretKey) != MLKem512SecretKeyBytes {
+		return nil, fmt.Errorf("%w: secret key must be exactly %d bytes, got %d bytes", pqc.ErrInvalidKeySize, MLKem512SecretKeyBytes, len(secretKey))
+	}
+
+	sharedSecret = make([]byte, MLKem512SharedSecretBytes)
+
+	result := C.PQCLEAN_MLKEM512_CLEAN_crypto_kem_dec(
+		(*C.uchar)(unsafe.Pointer(&sharedSecret[0])),
+		(*C.uchar)(unsafe.Pointer(&ciphertext[0])),
+		(*C.uchar)(unsafe.Pointer(&secretKey[0])),
+	)
+	if result != 0 {
+		return nil, fmt.Errorf("%w: code %d", pqc.ErrDecapsulation, result)
+	}
+
+	return sharedSecret, nil
+}
+
+// MLKem768 provides the ML-KEM-768 key encapsulation mechanism.
+type MLKem768 struct{}
+
+// NewMLKem768 creates a new MLKem768 instance.
+func NewMLKem768() *MLKem768 { return &MLKem768{} }
+
+// Name returns the canonical algorithm name, satisfying pqc.Scheme.
+func (k *MLKem768) Name() string { return "ML-KEM-768" }
+
+// PublicKeySize returns the public key size in bytes.
+func (k *MLKem768) PublicKeySize() int { return MLKem768PublicKeyBytes }
+
+// CiphertextSize returns the ciphertext size in bytes.
+func (k *MLKem768) CiphertextSize() int { return MLKem768CiphertextBytes }
+
+// Keypair generates an ML-KEM-768 key pair.
+func (k *MLKem768) Keypair() (publicKey, secretKey []byte, err error) {
+	if !pqc.LibraryAvailable() {
+		return nil, nil, pqc.ErrLibraryNotFound
+	}
+
+	publicKey = make([]byte, MLKem768PublicKeyBytes)
+	secretKey = make([]byte, MLKem768SecretKeyBytes)
+
+	result := C.PQCLEAN_MLKEM768_CLEAN_crypto_kem_keypair(
+		(*C.uchar)(unsafe.Pointer(&publicKey[0])),
+		(*C.uchar)(unsafe.Pointer(&secretKey[0])),
+	)
+	if result != 0 {
+		return nil, nil, fmt.Errorf("%w: code %d", pqc.ErrKeyGeneration, result)
+	}
+
+	return publicKey, secretKey, nil
+}
+
+// Encapsulate encapsulates a shared secret using the public key.
+func (k *MLKem768) Encapsulate(publicKey []byte) (ciphertext, sharedSecret []byte, err error) {
+	if !pqc.LibraryAvailable() {
+		return nil, nil, pqc.ErrLibraryNotFound
+	}
+	if len(publicKey) != MLKem768PublicKeyBytes {
+		return nil, nil, fmt.Errorf("%w: public key must be exactly %d bytes, got %d bytes", pqc.ErrInvalidKeySize, MLKem768PublicKeyBytes, len(publicKey))
+	}
+
+	ciphertext = make([]byte, MLKem768CiphertextBytes)
+	sharedSecret = make([]byte, MLKem768SharedSecretBytes)
+
+	result := C.PQCLEAN_MLKEM768_CLEAN_crypto_kem_enc(
+		(*C.uchar)(unsafe.Pointer(&ciphertext[0])),
+		(*C.uchar)(unsafe.Pointer(&sharedSecret[0])),
+		(*C.uchar)(unsafe.Pointer(&publicKey[0])),
+	)
+	if result != 0 {
+		return nil, nil, fmt.Errorf("%w: code %d", pqc.ErrEncapsulation, result)
+	}
+
+	return ciphertext, sharedSecret, nil
+}
+
+// Decapsulate decapsulates the shared secret using the secret key.
+func (k *MLKem768) Decapsulate(ciphertext, secretKey []byte) (sharedSecret []byte, err error) {
+	if !pqc.LibraryAvailable() {
+		return nil, pqc.ErrLibraryNotFound
+	}
+	if len(ciphertext) != MLKem768CiphertextBytes {
+		return nil, fmt.Errorf("%w: ciphertext must be exactly %d bytes, got %d bytes", pqc.ErrInvalidKeySize, MLKem768CiphertextBytes, len(ciphertext))
+	}
+	if len(secretKey) != MLKem768SecretKeyBytes {
+		return nil, fmt.Errorf("%w: secret key must be exactly %d bytes, got %d bytes", pqc.ErrInvalidKeySize, MLKem768SecretKeyBytes, len(secretKey))
+	}
+
+	sharedSecret = make([]byte, MLKem768SharedSecretBytes)
+
+	result := C.PQCLEAN_MLKEM768_CLEAN_crypto_kem_dec(
+		(*C.uchar)(unsafe.Pointer(&sharedSecret[0])),
+		(*C.uchar)(unsafe.Pointer(&ciphertext[0])),
+		(*C.uchar)(unsafe.Pointer(&secretKey[0])),
+	)
+	if result != 0 {
+		return nil, fmt.Errorf("%w: code %d", pqc.ErrDecapsulation, result)
+	}
+
+	return sharedSecret, nil
+}
+
+// MLKem1024 provides the ML-KEM-1024 key encapsulation mechanism.
+type MLKem1024 struct{}
+
+// NewMLKem1024 creates a new MLKem1024 instance.
+func NewMLKem1024() *MLKem1024 { return &MLKem1024{} }
+
+// Name returns the canonical algorithm name, satisfying pqc.Scheme.
+func (k *MLKem1024) Name() string { return "ML-KEM-1024" }
+
+// PublicKeySize returns the public key size in bytes.
+func (k *MLKem1024) PublicKeySize() int { return MLKem1024PublicKeyBytes }
+
+// CiphertextSize returns the ciphertext size in bytes.
+func (k *MLKem1024) CiphertextSize() int { return MLKem1024CiphertextBytes }
+
+// Keypair generates an ML-KEM-1024 key pair.
+func (k *MLKem1024) Keypair() (publicKey, secretKey []byte, err error) {
+	if !pqc.LibraryAvailable() {
+		return nil, nil, pqc.ErrLibraryNotFound
+	}
+
+	publicKey = make([]byte, MLKem1024PublicKeyBytes)
+	secretKey = make([]byte, MLKem1024SecretKeyBytes)
+
+	result := C.PQCLEAN_MLKEM1024_CLEAN_crypto_kem_keypair(
+		(*C.uchar)(unsafe.Pointer(&publicKey[0])),
+		(*C.uchar)(unsafe.Pointer(&secretKey[0])),
+	)
+	if result != 0 {
+		return nil, nil, fmt.Errorf("%w: code %d", pqc.ErrKeyGeneration, result)
+	}
+
+	return publicKey, secretKey, nil
+}
+
+// Encapsulate encapsulates a shared secret using the public key.
+func (k *MLKem1024) Encapsulate(publicKey []byte) (ciphertext, sharedSecret []byte, err error) {
+	if !pqc.LibraryAvailable() {
+		return nil, nil, pqc.ErrLibraryNotFound
+	}
+	if len(publicKey) != MLKem1024PublicKeyBytes {
+		return nil, nil, fmt.Errorf("%w: public key must be exactly %d bytes, got %d bytes", pqc.ErrInvalidKeySize, MLKem1024PublicKeyBytes, len(publicKey))
+	}
+
+	ciphertext = make([]byte, MLKem1024CiphertextBytes)
+	sharedSecret = make([]byte, MLKem1024SharedSecretBytes)
+
+	result := C.PQCLEAN_MLKEM1024_CLEAN_crypto_kem_enc(
+		(*C.uchar)(unsafe.Pointer(&ciphertext[0])),
+		(*C.uchar)(unsafe.Pointer(&sharedSecret[0])),
+		(*C.uchar)(unsafe.Pointer(&publicKey[0])),
+	)
+	if result != 0 {
+		return nil, nil, fmt.Errorf("%w: code %d", pqc.ErrEncapsulation, result)
+	}
+
+	return ciphertext, sharedSecret, nil
+}
+
+// Decapsulate decapsulates the shared secret using the secret key.
+func (k *MLKem1024) Decapsulate(ciphertext, secretKey []byte) (sharedSecret []byte, err error) {
+	if !pqc.LibraryAvailable() {
+		return nil, pqc.ErrLibraryNotFound
+	}
+	if len(ciphertext) != MLKem1024CiphertextBytes {
+		return nil, fmt.Errorf("%w: ciphertext must be exactly %d bytes, got %d bytes", pqc.ErrInvalidKeySize, MLKem1024CiphertextBytes, len(ciphertext))
+	}
+	if len(secretKey) != MLKem1024SecretKeyBytes {
+		return nil, fmt.Errorf("%w: secret key must be exactly %d bytes, got %d bytes", pqc.ErrInvalidKeySize, MLKem1024SecretKeyBytes, len(secretKey))
+	}
+
+	sharedSecret = make([]byte, MLKem1024SharedSecretBytes)
+
+	result := C.PQCLEAN_MLKEM1024_CLEAN_crypto_kem_dec(
+		(*C.uchar)(unsafe.Pointer(&sharedSecret[0])),
+		(*C.uchar)(unsafe.Pointer(&ciphertext[0])),
+		(*C.uchar)(unsafe.Pointer(&secretKey[0])),
+	)
+	if result != 0 {
+		return nil, fmt.Errorf("%w: code %d", pqc.ErrDecapsulation, result)
+	}
+
+	return sharedSecret, nil
+}
@@ -0,0 +1,79 @@
+package mlkem
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	pqc "github.com/QudsLab/PQChub/wrappers/go"
+)
+
+func TestMLKemKeypairEncapsulateDecapsulateRoundTrip(t *testing.T) {
+	if !pqc.LibraryAvailable() {
+		t.Skip("PQC library not found")
+	}
+
+	schemes := []pqc.Scheme{NewMLKem512(), NewMLKem768(), NewMLKem1024()}
+	for _, scheme := range schemes {
+		scheme := scheme
+		t.Run(scheme.Name(), func(t *testing.T) {
+			publicKey, secretKey, err := scheme.Keypair()
+			if err != nil {
+				t.Fatalf("Keypair: %v", err)
+			}
+			if len(publicKey) != scheme.PublicKeySize() {
+				t.Errorf("public key = %d bytes, want %d", len(publicKey), scheme.PublicKeySize())
+			}
+
+			ciphertext, sharedSecret1, err := scheme.Encapsulate(publicKey)
+			if err != nil {
+				t.Fatalf("Encapsulate: %v", err)
+			}
+			if len(ciphertext) != scheme.CiphertextSize() {
+				t.Errorf("ciphertext = %d bytes, want %d", len(ciphertext), scheme.CiphertextSize())
+			}
+
+			sharedSecret2, err := scheme.Decapsulate(ciphertext, secretKey)
+			if err != nil {
+				t.Fatalf("Decapsulate: %v", err)
+			}
+
+			if !bytes.Equal(sharedSecret1, sharedSecret2) {
+				t.Error("Decapsulate produced a different shared secret than Encapsulate")
+			}
+		})
+	}
+}
+
+func TestMLKemDecapsulateRejectsWrongLengthSecretKey(t *testing.T) {
+	if !pqc.LibraryAvailable() {
+		t.Skip("PQC library not found")
+	}
+
+	kem := NewMLKem768()
+	publicKey, _, err := kem.Keypair()
+	if err != nil {
+		t.Fatalf("Keypair: %v", err)
+	}
+	ciphertext, _, err := kem.Encapsulate(publicKey)
+	if err != nil {
+		t.Fatalf("Encapsulate: %v", err)
+	}
+
+	if _, err := kem.Decapsulate(ciphertext, make([]byte, MLKem768SecretKeyBytes-1)); !errors.Is(err, pqc.ErrInvalidKeySize) {
+		t.Errorf("got err = %v, want wrapping ErrInvalidKeySize", err)
+	}
+}
+
+func TestMLKemSchemesAreRegistered(t *testing.T) {
+	for _, name := range []string{"ML-KEM-512", "ML-KEM-768", "ML-KEM-1024"} {
+		scheme, ok := pqc.GetScheme(name)
+		if !ok {
+			t.Errorf("GetScheme(%q) not found among registered schemes", name)
+			continue
+		}
+		if scheme.Name() != name {
+			t.Errorf("GetScheme(%q).Name() = %q", name, scheme.Name())
+		}
+	}
+}
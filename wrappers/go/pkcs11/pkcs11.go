@@ -0,0 +1,320 @@
+// Package pkcs11 implements a pqc.SignerBackend that signs through a
+// PKCS#11 token instead of in Go memory, so a Dilithium/ML-DSA private
+// key can stay inside an HSM.
+package pkcs11
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	p11 "github.com/miekg/pkcs11"
+
+	pqc "github.com/QudsLab/PQChub/wrappers/go"
+)
+
+// Provisional CKM_ML_DSA_* mechanism codes from the OASIS PKCS#11 3.2
+// working draft. Tokens that ship before the mechanism is ratified may
+// use vendor-specific codes instead; Backend always requests the codes
+// below and has no setting to override them.
+const (
+	CKM_ML_DSA    = 0x00001060
+	CKM_ML_DSA_44 = 0x00001061
+	CKM_ML_DSA_65 = 0x00001062
+	CKM_ML_DSA_87 = 0x00001063
+)
+
+func mechanismFor(algorithm pqc.DilithiumAlgorithm) uint {
+	switch algorithm {
+	case pqc.Dilithium2Algorithm:
+		return CKM_ML_DSA_44
+	case pqc.Dilithium3Algorithm:
+		return CKM_ML_DSA_65
+	default:
+		return CKM_ML_DSA_87
+	}
+}
+
+// Common errors
+var (
+	ErrConfig             = errors.New("invalid pkcs11 configuration")
+	ErrKeyNotFound        = errors.New("pkcs11 object not found")
+	ErrNotExtractable     = errors.New("pkcs11 key is not extractable, cannot fall back to in-process signing")
+	ErrClosed             = errors.New("pkcs11 backend is closed")
+	ErrContextUnsupported = errors.New("pkcs11 backend does not support a signing context against the token")
+)
+
+// Config holds PKCS#11 URI-style configuration, e.g.
+// "pkcs11:token=my-token;object=signing-key;pin-value=1234".
+type Config struct {
+	ModulePath string // path to the PKCS#11 module (.so/.dll)
+	Token      string // CKA_LABEL of the token
+	Object     string // CKA_LABEL of the key object
+	PIN        string
+
+	// PoolSize bounds how many concurrent PKCS#11 sessions Backend opens
+	// against the token, so concurrent signers don't serialize on one
+	// session. Defaults to 4.
+	PoolSize int
+}
+
+// ParseURI parses a "pkcs11:key=value;key=value" configuration string.
+// The module path is not part of the URI scheme and must be set
+// separately on the returned Config.
+func ParseURI(uri string) (*Config, error) {
+	const scheme = "pkcs11:"
+	if !strings.HasPrefix(uri, scheme) {
+		return nil, fmt.Errorf("%w: missing %q scheme", ErrConfig, scheme)
+	}
+
+	cfg := &Config{}
+	for _, pair := range strings.Split(strings.TrimPrefix(uri, scheme), ";") {
+		if pair == "" {
+			continue
+		}
+		kv := strings.SplitN(pair, "=", 2)
+		if len(kv) != 2 {
+			return nil, fmt.Errorf("%w: malformed attribute %q", ErrConfig, pair)
+		}
+		switch kv[0] {
+		case "token":
+			cfg.Token = kv[1]
+		case "object":
+			cfg.Object = kv[1]
+		case "pin-value":
+			cfg.PIN = kv[1]
+		default:
+			return nil, fmt.Errorf("%w: unknown attribute %q", ErrConfig, kv[0])
+		}
+	}
+
+	return cfg, nil
+}
+
+// Backend is a pqc.SignerBackend that signs through a key held in a
+// PKCS#11 token. A pool of open sessions lets concurrent signers avoid
+// serializing on a single HSM session.
+type Backend struct {
+	ctx       *p11.Ctx
+	slot      uint
+	keyHandle p11.ObjectHandle
+	algorithm pqc.DilithiumAlgorithm
+
+	// mu guards fallbackSecretKey and closing, which Sign and Close touch
+	// from whatever goroutines are calling them concurrently.
+	mu sync.Mutex
+
+	// fallbackSecretKey is non-nil only when the token's key turned out
+	// to be extractable and the token does not support the CKM_ML_DSA_*
+	// mechanism directly; in that case signing falls back to the
+	// in-process cgo backend using these bytes.
+	fallbackSecretKey []byte
+
+	// closing is set under mu once Close has started, so Sign calls that
+	// haven't yet registered with wg are rejected instead of racing
+	// Close's drain of sessions.
+	closing bool
+	wg      sync.WaitGroup
+
+	sessions chan p11.SessionHandle
+}
+
+// Open opens a PKCS#11 module, logs into the token described by cfg, and
+// locates the signing key object. algorithm selects which CKM_ML_DSA_*
+// mechanism to request.
+func Open(cfg *Config, algorithm pqc.DilithiumAlgorithm) (*Backend, error) {
+	if cfg.ModulePath == "" {
+		return nil, fmt.Errorf("%w: ModulePath is required", ErrConfig)
+	}
+
+	ctx := p11.New(cfg.ModulePath)
+	if ctx == nil {
+		return nil, fmt.Errorf("%w: failed to load module %s", ErrConfig, cfg.ModulePath)
+	}
+	if err := ctx.Initialize(); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrConfig, err)
+	}
+
+	slot, err := findSlotByTokenLabel(ctx, cfg.Token)
+	if err != nil {
+		ctx.Finalize()
+		return nil, err
+	}
+
+	poolSize := cfg.PoolSize
+	if poolSize <= 0 {
+		poolSize = 4
+	}
+
+	backend := &Backend{
+		ctx:       ctx,
+		slot:      slot,
+		algorithm: algorithm,
+		sessions:  make(chan p11.SessionHandle, poolSize),
+	}
+
+	// PKCS#11 login state is per-token, not per-session: logging in on
+	// one session authenticates every session this application holds
+	// open against that token, and a second Login typically fails with
+	// CKR_USER_ALREADY_LOGGED_IN. So only the first session logs in.
+	for i := 0; i < poolSize; i++ {
+		session, err := ctx.OpenSession(slot, p11.CKF_SERIAL_SESSION|p11.CKF_RW_SESSION)
+		if err != nil {
+			backend.Close()
+			return nil, fmt.Errorf("%w: %v", ErrConfig, err)
+		}
+		if i == 0 {
+			if err := ctx.Login(session, p11.CKU_USER, cfg.PIN); err != nil {
+				backend.Close()
+				return nil, fmt.Errorf("%w: %v", ErrConfig, err)
+			}
+		}
+		backend.sessions <- session
+	}
+
+	session := <-backend.sessions
+	handle, err := findObjectByLabel(ctx, session, cfg.Object)
+	backend.sessions <- session
+	if err != nil {
+		backend.Close()
+		return nil, err
+	}
+	backend.keyHandle = handle
+
+	return backend, nil
+}
+
+func findSlotByTokenLabel(ctx *p11.Ctx, label string) (uint, error) {
+	slots, err := ctx.GetSlotList(true)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %v", ErrConfig, err)
+	}
+	for _, slot := range slots {
+		info, err := ctx.GetTokenInfo(slot)
+		if err != nil {
+			continue
+		}
+		if strings.TrimRight(info.Label, " \x00") == label {
+			return slot, nil
+		}
+	}
+	return 0, fmt.Errorf("%w: token %q", ErrKeyNotFound, label)
+}
+
+func findObjectByLabel(ctx *p11.Ctx, session p11.SessionHandle, label string) (p11.ObjectHandle, error) {
+	template := []*p11.Attribute{
+		p11.NewAttribute(p11.CKA_LABEL, label),
+		p11.NewAttribute(p11.CKA_CLASS, p11.CKO_PRIVATE_KEY),
+	}
+
+	if err := ctx.FindObjectsInit(session, template); err != nil {
+		return 0, fmt.Errorf("%w: %v", ErrConfig, err)
+	}
+	defer ctx.FindObjectsFinal(session)
+
+	handles, _, err := ctx.FindObjects(session, 1)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %v", ErrConfig, err)
+	}
+	if len(handles) == 0 {
+		return 0, fmt.Errorf("%w: object %q", ErrKeyNotFound, label)
+	}
+
+	return handles[0], nil
+}
+
+// Sign implements pqc.SignerBackend. algorithm must match the algorithm
+// Open was configured with; Sign does not trust callers to pick the
+// right CKM_ML_DSA_* mechanism (or fallback key size) for whatever key is
+// actually loaded in the token. Sign first attempts to sign through the
+// token's CKM_ML_DSA_* mechanism; if the token reports the mechanism is
+// unsupported and the key is CKA_EXTRACTABLE, it falls back to pulling
+// the key out once and signing in-process.
+//
+// context is only honored on the in-process fallback path. Passing the
+// CK_SIGN_ADDITIONAL_CONTEXT mechanism parameter the real token would
+// need isn't implemented yet, so Sign rejects a non-empty context rather
+// than silently signing without the domain separation the caller asked
+// for.
+func (b *Backend) Sign(algorithm pqc.DilithiumAlgorithm, message, context []byte) (signature []byte, err error) {
+	if algorithm != b.algorithm {
+		return nil, fmt.Errorf("%w: backend was opened for %s, not %s", ErrConfig, b.algorithm, algorithm)
+	}
+
+	b.mu.Lock()
+	if b.closing {
+		b.mu.Unlock()
+		return nil, ErrClosed
+	}
+	b.wg.Add(1)
+	fallback := b.fallbackSecretKey
+	b.mu.Unlock()
+	defer b.wg.Done()
+
+	if fallback != nil {
+		return pqc.NewInProcessBackend(fallback).Sign(algorithm, message, context)
+	}
+
+	if len(context) > 0 {
+		return nil, ErrContextUnsupported
+	}
+
+	session := <-b.sessions
+	defer func() { b.sessions <- session }()
+
+	mechanism := []*p11.Mechanism{p11.NewMechanism(mechanismFor(algorithm), nil)}
+	if err := b.ctx.SignInit(session, mechanism, b.keyHandle); err != nil {
+		if errors.Is(err, p11.Error(p11.CKR_MECHANISM_INVALID)) {
+			if fallback, fallbackErr := b.tryExtractFallback(session); fallbackErr == nil {
+				b.mu.Lock()
+				b.fallbackSecretKey = fallback
+				b.mu.Unlock()
+				return pqc.NewInProcessBackend(fallback).Sign(algorithm, message, context)
+			}
+		}
+		return nil, fmt.Errorf("pkcs11 sign init failed: %v", err)
+	}
+
+	signature, err = b.ctx.Sign(session, message)
+	if err != nil {
+		return nil, fmt.Errorf("pkcs11 sign failed: %v", err)
+	}
+
+	return signature, nil
+}
+
+// tryExtractFallback pulls the raw CKA_VALUE out of the token when the
+// requested mechanism is not supported, so Sign can fall back to
+// in-process signing. This only works when the key was provisioned with
+// CKA_EXTRACTABLE=true, which most HSMs disable by default for keys
+// meant to never leave hardware.
+func (b *Backend) tryExtractFallback(session p11.SessionHandle) ([]byte, error) {
+	attrs, err := b.ctx.GetAttributeValue(session, b.keyHandle, []*p11.Attribute{
+		p11.NewAttribute(p11.CKA_VALUE, nil),
+	})
+	if err != nil || len(attrs) == 0 || len(attrs[0].Value) == 0 {
+		return nil, ErrNotExtractable
+	}
+	return attrs[0].Value, nil
+}
+
+// Close logs out of and closes every pooled session and finalizes the
+// module. It waits for any Sign call already in flight to return its
+// session to the pool before closing the sessions channel, so a Sign
+// racing a Close can't send on a closed channel.
+func (b *Backend) Close() error {
+	b.mu.Lock()
+	b.closing = true
+	b.mu.Unlock()
+	b.wg.Wait()
+
+	close(b.sessions)
+	for session := range b.sessions {
+		b.ctx.Logout(session)
+		b.ctx.CloseSession(session)
+	}
+	b.ctx.Finalize()
+	b.ctx.Destroy()
+	return nil
+}
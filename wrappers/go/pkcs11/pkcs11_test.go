@@ -0,0 +1,54 @@
+package pkcs11
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseURI(t *testing.T) {
+	cfg, err := ParseURI("pkcs11:token=my-token;object=signing-key;pin-value=1234")
+	if err != nil {
+		t.Fatalf("ParseURI: %v", err)
+	}
+
+	if cfg.Token != "my-token" {
+		t.Errorf("Token = %q, want %q", cfg.Token, "my-token")
+	}
+	if cfg.Object != "signing-key" {
+		t.Errorf("Object = %q, want %q", cfg.Object, "signing-key")
+	}
+	if cfg.PIN != "1234" {
+		t.Errorf("PIN = %q, want %q", cfg.PIN, "1234")
+	}
+	if cfg.ModulePath != "" {
+		t.Errorf("ModulePath = %q, want empty (not part of the URI scheme)", cfg.ModulePath)
+	}
+}
+
+func TestParseURIIgnoresEmptyPairs(t *testing.T) {
+	cfg, err := ParseURI("pkcs11:token=my-token;;object=signing-key;")
+	if err != nil {
+		t.Fatalf("ParseURI: %v", err)
+	}
+	if cfg.Token != "my-token" || cfg.Object != "signing-key" {
+		t.Errorf("got Token=%q Object=%q", cfg.Token, cfg.Object)
+	}
+}
+
+func TestParseURIRejectsMissingScheme(t *testing.T) {
+	if _, err := ParseURI("token=my-token"); !errors.Is(err, ErrConfig) {
+		t.Errorf("got err = %v, want wrapping ErrConfig", err)
+	}
+}
+
+func TestParseURIRejectsMalformedAttribute(t *testing.T) {
+	if _, err := ParseURI("pkcs11:token"); !errors.Is(err, ErrConfig) {
+		t.Errorf("got err = %v, want wrapping ErrConfig", err)
+	}
+}
+
+func TestParseURIRejectsUnknownAttribute(t *testing.T) {
+	if _, err := ParseURI("pkcs11:bogus=value"); !errors.Is(err, ErrConfig) {
+		t.Errorf("got err = %v, want wrapping ErrConfig", err)
+	}
+}
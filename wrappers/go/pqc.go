@@ -10,6 +10,13 @@
 // Digital Signatures:
 //   - Dilithium2, Dilithium3, Dilithium5
 //
+// The standardized NIST variants of these algorithms, ML-KEM (FIPS 203)
+// and ML-DSA (FIPS 204), live in the sibling pqc/mlkem and pqc/mldsa
+// packages, since their wire formats differ from the round-3 schemes
+// above. The Kyber and ML-KEM KEMs, legacy or standardized, register
+// themselves as a Scheme so callers can select an algorithm by name; the
+// pqc/hybrid combiners are not registered.
+//
 // Example usage:
 //
 //	import "github.com/QudsLab/PQChub/wrappers/go/pqc"
@@ -92,7 +99,6 @@ import (
 	"os"
 	"path/filepath"
 	"runtime"
-	"unsafe"
 )
 
 // Version information
@@ -259,21 +265,21 @@ func validateKeyLength(key []byte, expectedLength int, keyType string) error {
 // Common key size constants
 const (
 	// Kyber512 constants
-	Kyber512PublicKeyBytes  = 800
-	Kyber512SecretKeyBytes  = 1632
-	Kyber512CiphertextBytes = 768
+	Kyber512PublicKeyBytes    = 800
+	Kyber512SecretKeyBytes    = 1632
+	Kyber512CiphertextBytes   = 768
 	Kyber512SharedSecretBytes = 32
 
 	// Kyber768 constants
-	Kyber768PublicKeyBytes  = 1184
-	Kyber768SecretKeyBytes  = 2400
-	Kyber768CiphertextBytes = 1088
+	Kyber768PublicKeyBytes    = 1184
+	Kyber768SecretKeyBytes    = 2400
+	Kyber768CiphertextBytes   = 1088
 	Kyber768SharedSecretBytes = 32
 
 	// Kyber1024 constants
-	Kyber1024PublicKeyBytes  = 1568
-	Kyber1024SecretKeyBytes  = 3168
-	Kyber1024CiphertextBytes = 1568
+	Kyber1024PublicKeyBytes    = 1568
+	Kyber1024SecretKeyBytes    = 3168
+	Kyber1024CiphertextBytes   = 1568
 	Kyber1024SharedSecretBytes = 32
 
 	// Dilithium2 constants
@@ -290,4 +296,19 @@ const (
 	Dilithium5PublicKeyBytes = 2592
 	Dilithium5SecretKeyBytes = 4864
 	Dilithium5SignatureBytes = 4595
-)
\ No newline at end of file
+
+	// Kyber512/768/1024 share the same symmetric seed sizes: a 64-byte
+	// keypair seed (rho || sigma) and a 32-byte encapsulation coin.
+	Kyber512SeedBytes  = 64
+	Kyber768SeedBytes  = 64
+	Kyber1024SeedBytes = 64
+
+	Kyber512EncapsulationCoinsBytes  = 32
+	Kyber768EncapsulationCoinsBytes  = 32
+	Kyber1024EncapsulationCoinsBytes = 32
+
+	// Dilithium2/3/5 share the same 32-byte keypair seed.
+	Dilithium2SeedBytes = 32
+	Dilithium3SeedBytes = 32
+	Dilithium5SeedBytes = 32
+)
@@ -0,0 +1,67 @@
+package pqc
+
+import "sync"
+
+// Scheme is implemented by the legacy Kyber round-3 wrappers in this
+// package and the standardized ML-KEM (FIPS 203) wrappers in pqc/mlkem,
+// so callers can select an algorithm by name and iterate the available
+// schemes generically. The pqc/hybrid combiners do not implement it.
+type Scheme interface {
+	// Name returns the canonical algorithm name, e.g. "Kyber768" or
+	// "ML-KEM-768".
+	Name() string
+	PublicKeySize() int
+	CiphertextSize() int
+	Keypair() (publicKey, secretKey []byte, err error)
+	Encapsulate(publicKey []byte) (ciphertext, sharedSecret []byte, err error)
+	Decapsulate(ciphertext, secretKey []byte) (sharedSecret []byte, err error)
+}
+
+var (
+	schemesMu sync.RWMutex
+	schemes   = map[string]Scheme{}
+)
+
+// RegisterScheme makes a Scheme available by name to GetScheme and
+// ListSchemes. Packages that add new KEM implementations (e.g. pqc/mlkem)
+// call this from an init function, mirroring the database/sql driver
+// registration pattern.
+func RegisterScheme(s Scheme) {
+	schemesMu.Lock()
+	defer schemesMu.Unlock()
+	schemes[s.Name()] = s
+}
+
+// GetScheme looks up a registered Scheme by name, e.g. "Kyber768" or
+// "ML-KEM-768".
+func GetScheme(name string) (Scheme, bool) {
+	schemesMu.RLock()
+	defer schemesMu.RUnlock()
+	s, ok := schemes[name]
+	return s, ok
+}
+
+// ListSchemes returns the names of every registered Scheme.
+func ListSchemes() []string {
+	schemesMu.RLock()
+	defer schemesMu.RUnlock()
+	names := make([]string, 0, len(schemes))
+	for name := range schemes {
+		names = append(names, name)
+	}
+	return names
+}
+
+func init() {
+	RegisterScheme(NewKyber512())
+	RegisterScheme(NewKyber768())
+	RegisterScheme(NewKyber1024())
+}
+
+// LibraryAvailable reports whether the native PQC library was located for
+// the current platform. Sibling packages that bind additional PQClean
+// algorithms (e.g. pqc/mlkem) use this before invoking their own cgo
+// entry points.
+func LibraryAvailable() bool {
+	return libraryPath != ""
+}
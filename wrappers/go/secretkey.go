@@ -0,0 +1,101 @@
+package pqc
+
+import (
+	"runtime"
+	"sync"
+)
+
+// unsafeOption gates SecretKey.Bytes behind an explicit, named argument
+// so call sites that reach past the handle to the raw bytes are visible
+// in review.
+type unsafeOption struct{}
+
+// Unsafe is passed to SecretKey.Bytes to acknowledge that the returned
+// slice aliases off-heap memory the caller must not retain past the
+// SecretKey's lifetime.
+var Unsafe = unsafeOption{}
+
+// SecretKey is an opaque handle to a post-quantum secret key. Its
+// backing storage is allocated off the Go heap (mmap on Unix,
+// VirtualAlloc on Windows), locked into RAM so it cannot be paged to
+// swap (mlock/VirtualLock), and excluded from core dumps where the
+// platform supports it (MADV_DONTDUMP on Linux). Secret bytes are never
+// left sitting in a plain Go slice the GC might copy or move.
+//
+// Given how large post-quantum secret keys are (up to 4864 bytes for
+// Dilithium5), keeping them off-heap and explicitly zeroized is worth
+// the extra allocation over the []byte the rest of this package still
+// returns by default; call the *Secure methods to opt in.
+type SecretKey struct {
+	mu     sync.Mutex
+	buf    []byte
+	closed bool
+}
+
+// newSecretKey copies data into locked, off-heap memory. The caller
+// remains responsible for zeroizing data itself if it no longer needs
+// the plain-heap copy.
+func newSecretKey(data []byte) (*SecretKey, error) {
+	buf, err := allocLocked(len(data))
+	if err != nil {
+		return nil, err
+	}
+	copy(buf, data)
+
+	sk := &SecretKey{buf: buf}
+	runtime.SetFinalizer(sk, (*SecretKey).Zeroize)
+	return sk, nil
+}
+
+// Len returns the key size in bytes, or 0 once Zeroize has been called.
+func (sk *SecretKey) Len() int {
+	sk.mu.Lock()
+	defer sk.mu.Unlock()
+	return len(sk.buf)
+}
+
+// Bytes returns the raw secret key bytes. The _ unsafeOption parameter
+// must be pqc.Unsafe, making the accessor's escape hatch grep-able and
+// obvious at every call site.
+func (sk *SecretKey) Bytes(_ unsafeOption) []byte {
+	sk.mu.Lock()
+	defer sk.mu.Unlock()
+	if sk.closed {
+		return nil
+	}
+	return sk.buf
+}
+
+// withBytes holds sk.mu for the duration of fn, passing it the raw
+// secret key bytes (nil once Zeroize has been called). Unlike Bytes,
+// the lock is not released until fn returns, so a concurrent Zeroize
+// cannot munmap the backing memory out from under a cgo call still
+// reading it; callers that hand the slice to C (DecapsulateSecure,
+// SignSecure) must go through this method rather than Bytes(Unsafe).
+func (sk *SecretKey) withBytes(fn func([]byte) error) error {
+	sk.mu.Lock()
+	defer sk.mu.Unlock()
+	if sk.closed {
+		return fn(nil)
+	}
+	return fn(sk.buf)
+}
+
+// Zeroize overwrites the key's backing memory and releases it. It is
+// safe to call more than once and runs automatically via a finalizer if
+// the caller forgets, though callers should not rely on finalizer
+// timing for secrets that must not linger in memory.
+func (sk *SecretKey) Zeroize() {
+	sk.mu.Lock()
+	defer sk.mu.Unlock()
+	if sk.closed {
+		return
+	}
+	for i := range sk.buf {
+		sk.buf[i] = 0
+	}
+	unlockAndFree(sk.buf)
+	sk.buf = nil
+	sk.closed = true
+	runtime.SetFinalizer(sk, nil)
+}
@@ -0,0 +1,45 @@
+//go:build linux
+
+package pqc
+
+import "golang.org/x/sys/unix"
+
+// allocLocked allocates size bytes of anonymous memory, mlocks it so it
+// cannot be paged to swap, and marks it MADV_DONTDUMP so it is excluded
+// from core dumps.
+//
+// This uses golang.org/x/sys/unix rather than the standard syscall
+// package: syscall.MADV_DONTDUMP and friends are only defined for a
+// handful of linux architectures in the standard library, not
+// linux/amd64, so a build targeting the most common deployment platform
+// for this module would fail outright.
+func allocLocked(size int) ([]byte, error) {
+	if size == 0 {
+		size = 1
+	}
+
+	buf, err := unix.Mmap(-1, 0, size, unix.PROT_READ|unix.PROT_WRITE, unix.MAP_PRIVATE|unix.MAP_ANON)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := unix.Mlock(buf); err != nil {
+		unix.Munmap(buf)
+		return nil, err
+	}
+
+	// Best-effort: older kernels may not support MADV_DONTDUMP.
+	_ = unix.Madvise(buf, unix.MADV_DONTDUMP)
+
+	return buf, nil
+}
+
+// unlockAndFree unlocks and unmaps memory allocated by allocLocked. The
+// caller must have already zeroed it.
+func unlockAndFree(buf []byte) {
+	if len(buf) == 0 {
+		return
+	}
+	unix.Munlock(buf)
+	unix.Munmap(buf)
+}
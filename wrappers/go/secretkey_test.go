@@ -0,0 +1,62 @@
+package pqc
+
+import "testing"
+
+func TestSecretKeyLenAndBytes(t *testing.T) {
+	data := []byte("a post-quantum secret key")
+	sk, err := newSecretKey(data)
+	if err != nil {
+		t.Fatalf("newSecretKey: %v", err)
+	}
+	defer sk.Zeroize()
+
+	if sk.Len() != len(data) {
+		t.Errorf("Len() = %d, want %d", sk.Len(), len(data))
+	}
+
+	got := sk.Bytes(Unsafe)
+	if string(got) != string(data) {
+		t.Errorf("Bytes() = %q, want %q", got, data)
+	}
+}
+
+func TestSecretKeyZeroizeClearsAndReleases(t *testing.T) {
+	sk, err := newSecretKey([]byte("zeroize me"))
+	if err != nil {
+		t.Fatalf("newSecretKey: %v", err)
+	}
+
+	sk.Zeroize()
+
+	if got := sk.Len(); got != 0 {
+		t.Errorf("Len() after Zeroize = %d, want 0", got)
+	}
+	if got := sk.Bytes(Unsafe); got != nil {
+		t.Errorf("Bytes() after Zeroize = %v, want nil", got)
+	}
+}
+
+func TestSecretKeyZeroizeIsIdempotent(t *testing.T) {
+	sk, err := newSecretKey([]byte("idempotent"))
+	if err != nil {
+		t.Fatalf("newSecretKey: %v", err)
+	}
+
+	sk.Zeroize()
+	sk.Zeroize() // must not panic or double-free
+}
+
+func TestSecretKeyEmptyKey(t *testing.T) {
+	// allocLocked floors its mmap size at 1 byte (mmap rejects a
+	// zero-length mapping), so an empty key still reports Len() == 1
+	// rather than 0.
+	sk, err := newSecretKey(nil)
+	if err != nil {
+		t.Fatalf("newSecretKey: %v", err)
+	}
+	defer sk.Zeroize()
+
+	if got := sk.Len(); got != 1 {
+		t.Errorf("Len() = %d, want 1", got)
+	}
+}
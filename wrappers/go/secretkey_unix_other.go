@@ -0,0 +1,42 @@
+//go:build !linux && !windows
+
+package pqc
+
+import "golang.org/x/sys/unix"
+
+// allocLocked allocates size bytes of anonymous memory and mlocks it so
+// it cannot be paged to swap. MADV_DONTDUMP is Linux-specific, so on
+// other Unix platforms secrets are not additionally excluded from core
+// dumps.
+//
+// This uses golang.org/x/sys/unix rather than the standard syscall
+// package: syscall.Mlock/syscall.Munlock are not defined for every BSD
+// GOOS/GOARCH pair the standard library supports, so a build targeting
+// some of these platforms would fail outright.
+func allocLocked(size int) ([]byte, error) {
+	if size == 0 {
+		size = 1
+	}
+
+	buf, err := unix.Mmap(-1, 0, size, unix.PROT_READ|unix.PROT_WRITE, unix.MAP_PRIVATE|unix.MAP_ANON)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := unix.Mlock(buf); err != nil {
+		unix.Munmap(buf)
+		return nil, err
+	}
+
+	return buf, nil
+}
+
+// unlockAndFree unlocks and unmaps memory allocated by allocLocked. The
+// caller must have already zeroed it.
+func unlockAndFree(buf []byte) {
+	if len(buf) == 0 {
+		return
+	}
+	unix.Munlock(buf)
+	unix.Munmap(buf)
+}
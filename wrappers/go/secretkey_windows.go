@@ -0,0 +1,56 @@
+//go:build windows
+
+package pqc
+
+import (
+	"fmt"
+	"syscall"
+	"unsafe"
+)
+
+const (
+	memCommit     = 0x00001000
+	memReserve    = 0x00002000
+	memRelease    = 0x00008000
+	pageReadWrite = 0x04
+)
+
+var (
+	kernel32          = syscall.NewLazyDLL("kernel32.dll")
+	procVirtualAlloc  = kernel32.NewProc("VirtualAlloc")
+	procVirtualFree   = kernel32.NewProc("VirtualFree")
+	procVirtualLock   = kernel32.NewProc("VirtualLock")
+	procVirtualUnlock = kernel32.NewProc("VirtualUnlock")
+)
+
+// allocLocked allocates size bytes via VirtualAlloc and locks them into
+// the process's working set with VirtualLock so they cannot be paged to
+// the swap file.
+func allocLocked(size int) ([]byte, error) {
+	if size == 0 {
+		size = 1
+	}
+
+	addr, _, err := procVirtualAlloc.Call(0, uintptr(size), memCommit|memReserve, pageReadWrite)
+	if addr == 0 {
+		return nil, fmt.Errorf("VirtualAlloc: %w", err)
+	}
+
+	if ret, _, err := procVirtualLock.Call(addr, uintptr(size)); ret == 0 {
+		procVirtualFree.Call(addr, 0, memRelease)
+		return nil, fmt.Errorf("VirtualLock: %w", err)
+	}
+
+	return unsafe.Slice((*byte)(unsafe.Pointer(addr)), size), nil
+}
+
+// unlockAndFree unlocks and releases memory allocated by allocLocked.
+// The caller must have already zeroed it.
+func unlockAndFree(buf []byte) {
+	if len(buf) == 0 {
+		return
+	}
+	addr := uintptr(unsafe.Pointer(&buf[0]))
+	procVirtualUnlock.Call(addr, uintptr(len(buf)))
+	procVirtualFree.Call(addr, 0, memRelease)
+}
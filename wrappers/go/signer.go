@@ -0,0 +1,300 @@
+package pqc
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// DilithiumAlgorithm identifies a round-3 Dilithium parameter set
+// (Dilithium2/3/5), so the crypto.Signer adapters below can be generic
+// over all three. Signing and verification use the round-3 Dilithium
+// bindings in this package, not the FIPS 204 ML-DSA ones in pqc/mldsa.
+type DilithiumAlgorithm int
+
+const (
+	Dilithium2Algorithm DilithiumAlgorithm = iota
+	Dilithium3Algorithm
+	Dilithium5Algorithm
+)
+
+// String returns the human-readable name of the algorithm.
+func (a DilithiumAlgorithm) String() string {
+	switch a {
+	case Dilithium2Algorithm:
+		return "Dilithium2"
+	case Dilithium3Algorithm:
+		return "Dilithium3"
+	case Dilithium5Algorithm:
+		return "Dilithium5"
+	default:
+		return "unknown"
+	}
+}
+
+// dilithiumOIDs assigns each parameter set the OID reserved for ML-DSA by
+// the IETF LAMPS drafts (draft-ietf-lamps-dilithium-certificates), reusing
+// the Dilithium round-3 parameter sets as ML-DSA-44/65/87.
+var dilithiumOIDs = map[DilithiumAlgorithm]asn1.ObjectIdentifier{
+	Dilithium2Algorithm: {2, 16, 840, 1, 101, 3, 4, 3, 17},
+	Dilithium3Algorithm: {2, 16, 840, 1, 101, 3, 4, 3, 18},
+	Dilithium5Algorithm: {2, 16, 840, 1, 101, 3, 4, 3, 19},
+}
+
+func algorithmForOID(oid asn1.ObjectIdentifier) (DilithiumAlgorithm, error) {
+	for alg, candidate := range dilithiumOIDs {
+		if candidate.Equal(oid) {
+			return alg, nil
+		}
+	}
+	return 0, fmt.Errorf("%w: unrecognized ML-DSA OID %s", ErrInvalidKeySize, oid)
+}
+
+func (a DilithiumAlgorithm) publicKeyBytes() int {
+	switch a {
+	case Dilithium2Algorithm:
+		return Dilithium2PublicKeyBytes
+	case Dilithium3Algorithm:
+		return Dilithium3PublicKeyBytes
+	default:
+		return Dilithium5PublicKeyBytes
+	}
+}
+
+func (a DilithiumAlgorithm) secretKeyBytes() int {
+	switch a {
+	case Dilithium2Algorithm:
+		return Dilithium2SecretKeyBytes
+	case Dilithium3Algorithm:
+		return Dilithium3SecretKeyBytes
+	default:
+		return Dilithium5SecretKeyBytes
+	}
+}
+
+func (a DilithiumAlgorithm) sign(message, context, secretKey []byte) ([]byte, error) {
+	switch a {
+	case Dilithium2Algorithm:
+		if len(context) > 0 {
+			return NewDilithium2().SignWithContext(message, context, secretKey)
+		}
+		return NewDilithium2().Sign(message, secretKey)
+	case Dilithium3Algorithm:
+		if len(context) > 0 {
+			return NewDilithium3().SignWithContext(message, context, secretKey)
+		}
+		return NewDilithium3().Sign(message, secretKey)
+	default:
+		if len(context) > 0 {
+			return NewDilithium5().SignWithContext(message, context, secretKey)
+		}
+		return NewDilithium5().Sign(message, secretKey)
+	}
+}
+
+func (a DilithiumAlgorithm) verify(message, signature, publicKey []byte) (bool, error) {
+	switch a {
+	case Dilithium2Algorithm:
+		return NewDilithium2().Verify(message, signature, publicKey)
+	case Dilithium3Algorithm:
+		return NewDilithium3().Verify(message, signature, publicKey)
+	default:
+		return NewDilithium5().Verify(message, signature, publicKey)
+	}
+}
+
+// SignerOpts carries the optional context string used by the Dilithium
+// `_ctx` signing entry points. A zero-value SignerOpts signs without a
+// context, equivalent to calling Sign directly.
+type SignerOpts struct {
+	Context []byte
+}
+
+// HashFunc implements crypto.SignerOpts. Dilithium signs the message
+// directly rather than a pre-hashed digest, so no hash is selected.
+func (o *SignerOpts) HashFunc() crypto.Hash {
+	return crypto.Hash(0)
+}
+
+// DilithiumPublicKey adapts a raw Dilithium public key to crypto.PublicKey
+// so it can be used with Go's x509/tls tooling.
+type DilithiumPublicKey struct {
+	Algorithm DilithiumAlgorithm
+	Key       []byte
+}
+
+// NewDilithiumPublicKey wraps a raw public key, validating its length for
+// the given algorithm.
+func NewDilithiumPublicKey(algorithm DilithiumAlgorithm, key []byte) (*DilithiumPublicKey, error) {
+	if err := validateKeyLength(key, algorithm.publicKeyBytes(), "public key"); err != nil {
+		return nil, err
+	}
+	return &DilithiumPublicKey{Algorithm: algorithm, Key: key}, nil
+}
+
+// Equal reports whether x is a DilithiumPublicKey with the same algorithm
+// and key bytes.
+func (pk *DilithiumPublicKey) Equal(x crypto.PublicKey) bool {
+	other, ok := x.(*DilithiumPublicKey)
+	if !ok {
+		return false
+	}
+	return pk.Algorithm == other.Algorithm && bytes.Equal(pk.Key, other.Key)
+}
+
+// DilithiumPrivateKey adapts a raw Dilithium key pair to crypto.Signer so
+// it can be used anywhere a tls.Certificate.PrivateKey or an
+// x509.CreateCertificate signer is expected.
+type DilithiumPrivateKey struct {
+	Algorithm DilithiumAlgorithm
+	Key       []byte // secret key
+	publicKey []byte
+}
+
+// NewDilithiumPrivateKey wraps a raw key pair, validating both key lengths
+// for the given algorithm.
+func NewDilithiumPrivateKey(algorithm DilithiumAlgorithm, publicKey, secretKey []byte) (*DilithiumPrivateKey, error) {
+	if err := validateKeyLength(publicKey, algorithm.publicKeyBytes(), "public key"); err != nil {
+		return nil, err
+	}
+	if err := validateKeyLength(secretKey, algorithm.secretKeyBytes(), "secret key"); err != nil {
+		return nil, err
+	}
+	return &DilithiumPrivateKey{Algorithm: algorithm, Key: secretKey, publicKey: publicKey}, nil
+}
+
+// Public returns the crypto.PublicKey corresponding to sk.
+func (sk *DilithiumPrivateKey) Public() crypto.PublicKey {
+	return &DilithiumPublicKey{Algorithm: sk.Algorithm, Key: sk.publicKey}
+}
+
+// Sign implements crypto.Signer. digest is the full message to sign, not
+// a pre-hashed digest, matching the convention Go's standard library uses
+// for ed25519.PrivateKey: opts.HashFunc() must be crypto.Hash(0), or Sign
+// rejects the call rather than silently signing a caller-supplied hash as
+// if it were the message. If opts is a *SignerOpts with a non-empty
+// Context, the PQClean `_ctx` entry point is used for domain separation.
+func (sk *DilithiumPrivateKey) Sign(_ io.Reader, digest []byte, opts crypto.SignerOpts) ([]byte, error) {
+	if opts.HashFunc() != crypto.Hash(0) {
+		return nil, errors.New("pqc: Dilithium does not support pre-hashed messages, opts.HashFunc() must be crypto.Hash(0)")
+	}
+
+	var context []byte
+	if o, ok := opts.(*SignerOpts); ok {
+		context = o.Context
+	}
+	return sk.Algorithm.sign(digest, context, sk.Key)
+}
+
+// Equal reports whether x is a DilithiumPrivateKey with the same algorithm
+// and key bytes.
+func (sk *DilithiumPrivateKey) Equal(x crypto.PrivateKey) bool {
+	other, ok := x.(*DilithiumPrivateKey)
+	if !ok {
+		return false
+	}
+	return sk.Algorithm == other.Algorithm && bytes.Equal(sk.Key, other.Key)
+}
+
+// pkixPublicKeyInfo mirrors the SubjectPublicKeyInfo ASN.1 structure from
+// RFC 5280, carrying the raw Dilithium public key bytes as the BIT STRING
+// payload.
+type pkixPublicKeyInfo struct {
+	Algorithm pkix.AlgorithmIdentifier
+	PublicKey asn1.BitString
+}
+
+// pkcs8PrivateKeyInfo mirrors the PrivateKeyInfo ASN.1 structure from
+// RFC 5958, carrying the raw secret key bytes concatenated with the
+// public key bytes as the OCTET STRING payload (Dilithium secret keys do
+// not embed an easily-recoverable public key, unlike RSA/EC).
+type pkcs8PrivateKeyInfo struct {
+	Version    int
+	Algorithm  pkix.AlgorithmIdentifier
+	PrivateKey []byte
+}
+
+// MarshalPKIXPublicKey encodes a Dilithium public key as a DER-encoded
+// SubjectPublicKeyInfo, using the ML-DSA OID for key.Algorithm.
+func MarshalPKIXPublicKey(key *DilithiumPublicKey) ([]byte, error) {
+	oid, ok := dilithiumOIDs[key.Algorithm]
+	if !ok {
+		return nil, fmt.Errorf("%w: unsupported Dilithium algorithm", ErrInvalidKeySize)
+	}
+
+	info := pkixPublicKeyInfo{
+		Algorithm: pkix.AlgorithmIdentifier{Algorithm: oid},
+		PublicKey: asn1.BitString{Bytes: key.Key, BitLength: len(key.Key) * 8},
+	}
+
+	return asn1.Marshal(info)
+}
+
+// ParsePKIXPublicKey decodes a DER-encoded SubjectPublicKeyInfo produced
+// by MarshalPKIXPublicKey back into a DilithiumPublicKey.
+func ParsePKIXPublicKey(der []byte) (*DilithiumPublicKey, error) {
+	var info pkixPublicKeyInfo
+	if rest, err := asn1.Unmarshal(der, &info); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidKeySize, err)
+	} else if len(rest) != 0 {
+		return nil, fmt.Errorf("%w: trailing data after SubjectPublicKeyInfo", ErrInvalidKeySize)
+	}
+
+	algorithm, err := algorithmForOID(info.Algorithm.Algorithm)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewDilithiumPublicKey(algorithm, info.PublicKey.RightAlign())
+}
+
+// MarshalPKCS8PrivateKey encodes a Dilithium key pair as a DER-encoded
+// PrivateKeyInfo, using the ML-DSA OID for key.Algorithm.
+func MarshalPKCS8PrivateKey(key *DilithiumPrivateKey) ([]byte, error) {
+	oid, ok := dilithiumOIDs[key.Algorithm]
+	if !ok {
+		return nil, fmt.Errorf("%w: unsupported Dilithium algorithm", ErrInvalidKeySize)
+	}
+
+	payload := make([]byte, 0, len(key.Key)+len(key.publicKey))
+	payload = append(payload, key.Key...)
+	payload = append(payload, key.publicKey...)
+
+	info := pkcs8PrivateKeyInfo{
+		Version:    0,
+		Algorithm:  pkix.AlgorithmIdentifier{Algorithm: oid},
+		PrivateKey: payload,
+	}
+
+	return asn1.Marshal(info)
+}
+
+// ParsePKCS8PrivateKey decodes a DER-encoded PrivateKeyInfo produced by
+// MarshalPKCS8PrivateKey back into a DilithiumPrivateKey.
+func ParsePKCS8PrivateKey(der []byte) (*DilithiumPrivateKey, error) {
+	var info pkcs8PrivateKeyInfo
+	if rest, err := asn1.Unmarshal(der, &info); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidKeySize, err)
+	} else if len(rest) != 0 {
+		return nil, fmt.Errorf("%w: trailing data after PrivateKeyInfo", ErrInvalidKeySize)
+	}
+
+	algorithm, err := algorithmForOID(info.Algorithm.Algorithm)
+	if err != nil {
+		return nil, err
+	}
+
+	secretLen := algorithm.secretKeyBytes()
+	if len(info.PrivateKey) != secretLen+algorithm.publicKeyBytes() {
+		return nil, fmt.Errorf("%w: PrivateKeyInfo payload has unexpected length %d", ErrInvalidKeySize, len(info.PrivateKey))
+	}
+
+	secretKey := info.PrivateKey[:secretLen]
+	publicKey := info.PrivateKey[secretLen:]
+
+	return NewDilithiumPrivateKey(algorithm, publicKey, secretKey)
+}
@@ -0,0 +1,83 @@
+package pqc
+
+import (
+	"bytes"
+	"crypto"
+	"testing"
+)
+
+func TestMarshalParsePKIXPublicKeyRoundTrip(t *testing.T) {
+	key := bytes.Repeat([]byte{0x01}, Dilithium3PublicKeyBytes)
+	pub, err := NewDilithiumPublicKey(Dilithium3Algorithm, key)
+	if err != nil {
+		t.Fatalf("NewDilithiumPublicKey: %v", err)
+	}
+
+	der, err := MarshalPKIXPublicKey(pub)
+	if err != nil {
+		t.Fatalf("MarshalPKIXPublicKey: %v", err)
+	}
+
+	parsed, err := ParsePKIXPublicKey(der)
+	if err != nil {
+		t.Fatalf("ParsePKIXPublicKey: %v", err)
+	}
+
+	if !parsed.Equal(pub) {
+		t.Error("round-tripped public key does not equal the original")
+	}
+}
+
+func TestMarshalParsePKCS8PrivateKeyRoundTrip(t *testing.T) {
+	publicKey := bytes.Repeat([]byte{0x02}, Dilithium2PublicKeyBytes)
+	secretKey := bytes.Repeat([]byte{0x03}, Dilithium2SecretKeyBytes)
+	priv, err := NewDilithiumPrivateKey(Dilithium2Algorithm, publicKey, secretKey)
+	if err != nil {
+		t.Fatalf("NewDilithiumPrivateKey: %v", err)
+	}
+
+	der, err := MarshalPKCS8PrivateKey(priv)
+	if err != nil {
+		t.Fatalf("MarshalPKCS8PrivateKey: %v", err)
+	}
+
+	parsed, err := ParsePKCS8PrivateKey(der)
+	if err != nil {
+		t.Fatalf("ParsePKCS8PrivateKey: %v", err)
+	}
+
+	if !parsed.Equal(priv) {
+		t.Error("round-tripped private key does not equal the original")
+	}
+}
+
+func TestParsePKCS8PrivateKeyRejectsWrongLengthPayload(t *testing.T) {
+	publicKey := bytes.Repeat([]byte{0x02}, Dilithium2PublicKeyBytes)
+	secretKey := bytes.Repeat([]byte{0x03}, Dilithium2SecretKeyBytes)
+	priv, err := NewDilithiumPrivateKey(Dilithium2Algorithm, publicKey, secretKey)
+	if err != nil {
+		t.Fatalf("NewDilithiumPrivateKey: %v", err)
+	}
+
+	der, err := MarshalPKCS8PrivateKey(priv)
+	if err != nil {
+		t.Fatalf("MarshalPKCS8PrivateKey: %v", err)
+	}
+
+	if _, err := ParsePKCS8PrivateKey(der[:len(der)-1]); err == nil {
+		t.Error("expected an error parsing a truncated PrivateKeyInfo payload")
+	}
+}
+
+func TestDilithiumPrivateKeySignRejectsPreHashedOpts(t *testing.T) {
+	publicKey := bytes.Repeat([]byte{0x02}, Dilithium2PublicKeyBytes)
+	secretKey := bytes.Repeat([]byte{0x03}, Dilithium2SecretKeyBytes)
+	priv, err := NewDilithiumPrivateKey(Dilithium2Algorithm, publicKey, secretKey)
+	if err != nil {
+		t.Fatalf("NewDilithiumPrivateKey: %v", err)
+	}
+
+	if _, err := priv.Sign(nil, []byte("digest"), crypto.SHA256); err == nil {
+		t.Error("expected Sign to reject opts with a non-zero HashFunc")
+	}
+}